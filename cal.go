@@ -2,7 +2,13 @@
 
 package cal
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
 
 // IsWeekend reports whether the given date falls on a weekend.
 func IsWeekend(date time.Time) bool {
@@ -59,6 +65,26 @@ func MonthEnd(t time.Time) time.Time {
 		t.Second(), t.Nanosecond(), t.Location())
 }
 
+// WeekdayOnOrAfter reports the first date on or after anchor that falls on
+// the given weekday. The time portion is unchanged.
+func WeekdayOnOrAfter(anchor time.Time, weekday time.Weekday) time.Time {
+	diff := (int(weekday) - int(anchor.Weekday()) + 7) % 7
+	return anchor.AddDate(0, 0, diff)
+}
+
+// WeekdayOnOrBefore reports the last date on or before anchor that falls on
+// the given weekday. The time portion is unchanged.
+func WeekdayOnOrBefore(anchor time.Time, weekday time.Weekday) time.Time {
+	diff := (int(anchor.Weekday()) - int(weekday) + 7) % 7
+	return anchor.AddDate(0, 0, -diff)
+}
+
+// WeekdayBefore reports the last date strictly before anchor that falls on
+// the given weekday. The time portion is unchanged.
+func WeekdayBefore(anchor time.Time, weekday time.Weekday) time.Time {
+	return WeekdayOnOrBefore(anchor.AddDate(0, 0, -1), weekday)
+}
+
 // JulianDayNumber reports the Julian Day Number for t. Note that Julian days
 // start at 12:00 UTC.
 func JulianDayNumber(t time.Time) int {
@@ -87,36 +113,335 @@ func JulianDate(t time.Time) float32 {
 }
 
 // Calendar represents a yearly calendar with a list of holidays.
+//
+// If Location is set, dates are converted to that time zone before they are
+// checked, so a UTC timestamp late on December 31st can match a New Year's
+// holiday in a time zone ahead of UTC.
+//
+// EasterMethod selects how Easter based holidays are calculated for the whole
+// calendar.
+//
+// WorkdayFunc, if set, replaces the work day decision of IsWorkday. It may
+// call DefaultWorkday to build on the built-in rules.
+//
+// A Calendar is safe for concurrent use by multiple goroutines once it is set
+// up, as long as nothing adds or removes holidays or changes its fields at the
+// same time.
 type Calendar struct {
-	holidays [13][]Holiday // 0 for offset based holidays, 1-12 for month based
-	Observed ObservedRule
+	holidays     [13][]Holiday      // 0 for offset based holidays, 1-12 for month based
+	workday      [7]bool            // indexed by time.Weekday
+	weekmasks    []weekmaskChange   // later work weeks, by date
+	customRules  int                // number of holidays with their own observance or HalfDay
+	overrides    map[dateKey]bool   // weekend dates that are work days
+	hijriStarts  map[hijriMonth]int // announced starts of Hijri months
+	Observed     ObservedRule
+	Location     *time.Location
+	EasterMethod EasterMethod
+	WorkdayFunc  WorkdayFunc
+}
+
+// dateKey identifies a date regardless of its time and location.
+type dateKey struct {
+	year  int
+	month time.Month
+	day   int
 }
 
-// NewCalendar creates a new Calendar with no holidays defined.
+// before reports whether k is a date before o.
+func (k dateKey) before(o dateKey) bool {
+	if k.year != o.year {
+		return k.year < o.year
+	}
+	if k.month != o.month {
+		return k.month < o.month
+	}
+	return k.day < o.day
+}
+
+// weekmaskChange is a work week that takes effect on a date.
+type weekmaskChange struct {
+	from dateKey
+	mask [7]bool
+}
+
+// key reports the dateKey of the date in the calendar's Location.
+func (c *Calendar) key(date time.Time) dateKey {
+	date = c.local(date)
+	return dateKey{date.Year(), date.Month(), date.Day()}
+}
+
+// WorkdayFunc reports whether a given date is a work day for the calendar.
+type WorkdayFunc func(c *Calendar, date time.Time) bool
+
+// NewCalendar creates a new Calendar with no holidays defined and a work week
+// of Monday through Friday.
 func NewCalendar() *Calendar {
 	c := &Calendar{}
 	for i := range c.holidays {
 		c.holidays[i] = make([]Holiday, 0, 2)
 	}
+	for d := time.Monday; d <= time.Friday; d++ {
+		c.workday[d] = true
+	}
 	return c
 }
 
-// AddHoliday adds a holiday to the calendar's list.
+// SetWorkday sets whether the given day of the week is a work day.
+func (c *Calendar) SetWorkday(day time.Weekday, workday bool) {
+	c.workday[day] = workday
+}
+
+// SetWeekmask sets the work days of the week in one call. The mask is indexed
+// by time.Weekday and must contain at least one work day.
+func (c *Calendar) SetWeekmask(mask [7]bool) error {
+	if !hasWorkday(mask) {
+		return errors.New("cal: weekmask has no work days")
+	}
+	c.workday = mask
+	return nil
+}
+
+// SetWeekmaskFrom sets the work days of the week from the given date on, such
+// as for a country that moved its weekend. The work week set by SetWeekmask
+// and SetWorkday applies before the first such change. The mask is indexed by
+// time.Weekday and must contain at least one work day.
+func (c *Calendar) SetWeekmaskFrom(from time.Time, mask [7]bool) error {
+	if !hasWorkday(mask) {
+		return errors.New("cal: weekmask has no work days")
+	}
+	change := weekmaskChange{c.key(from), mask}
+	i := sort.Search(len(c.weekmasks), func(i int) bool {
+		return !c.weekmasks[i].from.before(change.from)
+	})
+	if i < len(c.weekmasks) && c.weekmasks[i].from == change.from {
+		c.weekmasks[i] = change
+		return nil
+	}
+	c.weekmasks = append(c.weekmasks, weekmaskChange{})
+	copy(c.weekmasks[i+1:], c.weekmasks[i:])
+	c.weekmasks[i] = change
+	return nil
+}
+
+// hasWorkday reports whether the mask contains at least one work day.
+func hasWorkday(mask [7]bool) bool {
+	for _, workday := range mask {
+		if workday {
+			return true
+		}
+	}
+	return false
+}
+
+// weekmask reports the work days of the week in effect on the given date.
+func (c *Calendar) weekmask(date time.Time) [7]bool {
+	mask := c.workday
+	if len(c.weekmasks) == 0 {
+		return mask
+	}
+	k := c.key(date)
+	for _, w := range c.weekmasks {
+		if k.before(w.from) {
+			break
+		}
+		mask = w.mask
+	}
+	return mask
+}
+
+// AddWorkdayOverride makes the given date a work day even though it falls on
+// a non-working day of the calendar's work week, such as a Saturday worked to
+// make up for a long holiday. Holidays on the date still apply.
+func (c *Calendar) AddWorkdayOverride(date time.Time) {
+	if c.overrides == nil {
+		c.overrides = make(map[dateKey]bool)
+	}
+	c.overrides[c.key(date)] = true
+}
+
+// DayTransfer is a day off moved from one date to another, as decreed in some
+// countries to join holidays into longer breaks.
+type DayTransfer struct {
+	From time.Time // the day given up, often a Saturday that is worked
+	To   time.Time // the day taken off instead
+}
+
+// AddDayTransfers adds the days off of the transfers to Calendar as holidays
+// with the given name. A From date that falls on a non-working day of the
+// work week without a holiday of its own becomes a work day.
+func (c *Calendar) AddDayTransfers(name string, transfers ...DayTransfer) {
+	for _, t := range transfers {
+		if c.IsWeekend(t.From) && !c.IsHoliday(t.From) {
+			c.AddWorkdayOverride(t.From)
+		}
+		to := c.local(t.To)
+		c.AddHoliday(named(NewHolidayExact(to.Year(), to.Month(), to.Day()), name))
+	}
+}
+
+// IsWeekend reports whether the given date falls on a day of the week that is
+// not a work day for the calendar.
+func (c *Calendar) IsWeekend(date time.Time) bool {
+	return !c.weekmask(date)[c.local(date).Weekday()]
+}
+
+// local converts date to the calendar's Location, if any.
+func (c *Calendar) local(date time.Time) time.Time {
+	if c.Location == nil {
+		return date
+	}
+	return date.In(c.Location)
+}
+
+// loc reports the time zone used for dates created by the calendar.
+func (c *Calendar) loc() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
+
+// AddHoliday adds a holiday to the calendar's list. A holiday that is Equal
+// to one already in the list is not added again.
 func (c *Calendar) AddHoliday(h Holiday) {
-	c.holidays[h.Month] = append(c.holidays[h.Month], h)
+	idx := h.index()
+	for i := range c.holidays[idx] {
+		if c.holidays[idx][i].Equal(h) {
+			return
+		}
+	}
+	c.holidays[idx] = append(c.holidays[idx], h)
+	if h.ownObserved() {
+		c.customRules++
+	}
+}
+
+// AddHolidays adds each of the holidays to the calendar's list.
+func (c *Calendar) AddHolidays(hs ...Holiday) {
+	for _, h := range hs {
+		c.AddHoliday(h)
+	}
+}
+
+// RemoveHoliday removes the holiday that is Equal to h from the calendar's
+// list and reports whether there was one.
+func (c *Calendar) RemoveHoliday(h Holiday) bool {
+	idx := h.index()
+	for i := range c.holidays[idx] {
+		if c.holidays[idx][i].Equal(h) {
+			c.holidays[idx] = append(c.holidays[idx][:i], c.holidays[idx][i+1:]...)
+			if h.ownObserved() {
+				c.customRules--
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// ClearHolidays removes all holidays from the calendar's list.
+func (c *Calendar) ClearHolidays() {
+	for i := range c.holidays {
+		c.holidays[i] = c.holidays[i][:0]
+	}
+	c.customRules = 0
+}
+
+// MergeCalendars creates a new Calendar whose non-working days are those of
+// either a or b. Each holiday keeps the ObservedRule of the calendar it came
+// from, and a day of the week is a work day only if it is one in both. Easter
+// based holidays of a calendar using EasterJulian become Orthodox ones, so the
+// new Calendar uses EasterGregorian. The Location of a is used, and so are its
+// Hijri month starts where both set one.
+func MergeCalendars(a, b *Calendar) *Calendar {
+	c := NewCalendar()
+	c.Observed = a.Observed
+	c.Location = a.Location
+	for d := range c.workday {
+		c.workday[d] = a.workday[d] && b.workday[d]
+	}
+	for _, from := range []*Calendar{a, b} {
+		for idx := range from.holidays {
+			for _, h := range from.holidays[idx] {
+				if !h.ownObserved() {
+					h = NewHolidayObserved(h, from.Observed)
+				}
+				if from.EasterMethod == EasterJulian {
+					h = julianEaster(h)
+				}
+				c.AddHoliday(h)
+			}
+		}
+	}
+	for _, from := range []*Calendar{a, b} {
+		for key := range from.overrides {
+			d := time.Date(key.year, key.month, key.day, 12, 0, 0, 0, c.loc())
+			if (a.workday[d.Weekday()] || a.overrides[a.key(d)]) &&
+				(b.workday[d.Weekday()] || b.overrides[b.key(d)]) {
+				c.AddWorkdayOverride(d)
+			}
+		}
+	}
+	for _, from := range []*Calendar{b, a} {
+		for m, d := range from.hijriStarts {
+			if c.hijriStarts == nil {
+				c.hijriStarts = make(map[hijriMonth]int)
+			}
+			c.hijriStarts[m] = d
+		}
+	}
+	// the holidays alone cannot express these
+	if a.WorkdayFunc != nil || b.WorkdayFunc != nil ||
+		len(a.weekmasks) > 0 || len(b.weekmasks) > 0 {
+		c.WorkdayFunc = func(c *Calendar, date time.Time) bool {
+			return a.IsWorkday(date) && b.IsWorkday(date)
+		}
+	}
+	return c
+}
+
+// julianEaster returns a copy of the holiday that is calculated from Orthodox
+// Easter if it, or the holiday it is relative to, is Easter based.
+func julianEaster(h Holiday) Holiday {
+	if h.Easter {
+		h.Orthodox = true
+	}
+	if h.Base != nil {
+		base := julianEaster(*h.Base)
+		h.Base = &base
+	}
+	return h
+}
+
+// CommonWorkdays creates a new Calendar on which a day is a work day only if it
+// is one in all of the calendars, as MergeCalendars does for two. Without any
+// calendars it is the same as NewCalendar.
+func CommonWorkdays(cals ...*Calendar) *Calendar {
+	if len(cals) == 0 {
+		return NewCalendar()
+	}
+	c := cals[0]
+	for _, other := range cals[1:] {
+		c = MergeCalendars(c, other)
+	}
+	if len(cals) == 1 {
+		c = MergeCalendars(c, c)
+	}
+	return c
 }
 
 // IsHoliday reports whether a given date is a holiday. It does not account
 // for the observation of holidays on alternate days.
 func (c *Calendar) IsHoliday(date time.Time) bool {
+	date = c.local(date)
 	idx := date.Month()
 	for i := range c.holidays[idx] {
-		if c.holidays[idx][i].matches(date) {
+		if c.holidays[idx][i].matches(date, c) {
 			return true
 		}
 	}
 	for i := range c.holidays[0] {
-		if c.holidays[0][i].matches(date) {
+		if c.holidays[0][i].matches(date, c) {
 			return true
 		}
 	}
@@ -125,30 +450,457 @@ func (c *Calendar) IsHoliday(date time.Time) bool {
 
 // IsWorkday reports whether a given date is a work day (business day).
 func (c *Calendar) IsWorkday(date time.Time) bool {
-	if IsWeekend(date) || c.IsHoliday(date) {
+	if c.WorkdayFunc != nil {
+		return c.WorkdayFunc(c, date)
+	}
+	return DefaultWorkday(c, date)
+}
+
+// DefaultWorkday reports whether a given date is a work day according to the
+// calendar's work week, holidays and ObservedRule, ignoring its WorkdayFunc.
+func DefaultWorkday(c *Calendar, date time.Time) bool {
+	date = c.local(date)
+	if c.IsWeekend(date) && !c.overrides[c.key(date)] {
+		return false
+	}
+	if c.customRules > 0 {
+		for _, h := range c.observedHolidays(date) {
+			if !h.HalfDay {
+				return false
+			}
+		}
+		return true
+	}
+	if c.IsHoliday(date) {
 		return false
 	}
+	return len(c.observedOn(date)) == 0
+}
 
+// observedOn reports the dates of the weekend holidays that are observed on
+// the given date according to the calendar's ObservedRule. Holidays with their
+// own Observed rule or ObservedFunc are not considered.
+func (c *Calendar) observedOn(date time.Time) []time.Time {
 	if c.Observed == ObservedExact {
+		return nil
+	}
+	var dates []time.Time
+	for n := -cascadeLimit - 2; n <= cascadeLimit+2; n++ {
+		d := date.AddDate(0, 0, n)
+		if n == 0 || !isMoved(c.Observed, d) || !c.isRuleHoliday(d) {
+			continue
+		}
+		if c.sameDay(c.observedTarget(c.Observed, d), date) {
+			dates = append(dates, d)
+		}
+	}
+	return dates
+}
+
+// cascadeLimit is the number of days a moved holiday skips at most.
+const cascadeLimit = 3
+
+// observedTarget reports the day on which holidays falling on the given date
+// are observed according to the rule. A holiday moved forward skips days with
+// a holiday of their own, or taken by a holiday on the Saturday before, so
+// that Christmas on a Sunday is observed on Tuesday when Boxing Day is on the
+// Monday. A holiday moved back to the Friday stays there even if the Friday is
+// a holiday too.
+func (c *Calendar) observedTarget(rule ObservedRule, date time.Time) time.Time {
+	if !isMoved(rule, date) {
+		return date
+	}
+	var target, taken time.Time
+	switch {
+	case rule == ObservedMonday:
+		target = WeekdayOnOrAfter(date, time.Monday)
+		if sat := date.AddDate(0, 0, -1); date.Weekday() == time.Sunday && c.hasHolidayMovedBy(rule, sat) {
+			taken = c.observedTarget(rule, sat)
+		}
+	case date.Weekday() == time.Saturday:
+		target = date.AddDate(0, 0, -1)
+	default:
+		target = date.AddDate(0, 0, 1)
+	}
+	if target.Before(date) {
+		return target
+	}
+	for i := 0; i < cascadeLimit; i++ {
+		if !c.hasFullHoliday(target) && !c.IsWeekend(target) && !c.sameDay(target, taken) {
+			break
+		}
+		target = target.AddDate(0, 0, 1)
+	}
+	return target
+}
+
+// nextFreeDay reports the first day after the given date that is neither a
+// weekend day nor a full holiday, looking no further than observedWindow.
+func (c *Calendar) nextFreeDay(date time.Time) time.Time {
+	d := date.AddDate(0, 0, 1)
+	for i := 0; i < observedWindow && (c.IsWeekend(d) || c.hasFullHoliday(d)); i++ {
+		d = d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// isRuleHoliday reports whether a holiday observed according to the
+// calendar's ObservedRule falls on the given date.
+func (c *Calendar) isRuleHoliday(date time.Time) bool {
+	return c.IsHoliday(date) && (c.customRules == 0 || c.hasRuleHoliday(date))
+}
+
+// hasFullHoliday reports whether a holiday other than a HalfDay one falls on
+// the given date.
+func (c *Calendar) hasFullHoliday(date time.Time) bool {
+	if c.customRules == 0 {
+		return c.IsHoliday(date)
+	}
+	for _, h := range c.HolidaysOn(date) {
+		if !h.HalfDay {
+			return true
+		}
+	}
+	return false
+}
+
+// hasHolidayMovedBy reports whether a holiday other than a HalfDay one that
+// is observed according to the rule falls on the given date.
+func (c *Calendar) hasHolidayMovedBy(rule ObservedRule, date time.Time) bool {
+	if c.customRules == 0 {
+		return rule == c.Observed && c.IsHoliday(date)
+	}
+	for _, h := range c.HolidaysOn(date) {
+		if h.HalfDay || h.ObservedFunc != nil {
+			continue
+		}
+		r := c.Observed
+		if h.Observed != nil {
+			r = *h.Observed
+		}
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRuleHoliday reports whether a holiday observed according to the
+// calendar's ObservedRule falls on the given date.
+func (c *Calendar) hasRuleHoliday(date time.Time) bool {
+	for _, h := range c.HolidaysOn(date) {
+		if !h.ownObserved() {
+			return true
+		}
+	}
+	return false
+}
+
+// isObservedHoliday reports whether a holiday is observed on the given date,
+// either because it falls on that date and is not moved by the calendar's
+// ObservedRule, or because a weekend holiday is moved to it.
+func (c *Calendar) isObservedHoliday(date time.Time) bool {
+	if c.customRules > 0 {
+		return len(c.observedHolidays(date)) > 0
+	}
+	if !isMoved(c.Observed, date) && c.IsHoliday(date) {
 		return true
 	}
+	return len(c.observedOn(date)) > 0
+}
+
+// isMoved reports whether holidays falling on the given date are observed on
+// another day according to the rule.
+func isMoved(rule ObservedRule, date time.Time) bool {
+	switch date.Weekday() {
+	case time.Saturday:
+		return rule != ObservedExact && rule != ObservedSundayToMonday
+	case time.Sunday:
+		return rule != ObservedExact && rule != ObservedSaturdayToFriday
+	}
+	return false
+}
 
-	day := date.Weekday()
-	if c.Observed == ObservedMonday && day == time.Monday {
-		sun := date.AddDate(0, 0, -1)
-		sat := date.AddDate(0, 0, -2)
-		return !c.IsHoliday(sat) && !c.IsHoliday(sun)
-	} else if c.Observed == ObservedNearest {
-		if day == time.Friday {
-			sat := date.AddDate(0, 0, 1)
-			return !c.IsHoliday(sat)
-		} else if day == time.Monday {
-			sun := date.AddDate(0, 0, -1)
-			return !c.IsHoliday(sun)
+// observedWindow is the number of days before and after its date that a
+// holiday with its own observance is looked for.
+const observedWindow = 7
+
+// observedHolidays reports the holidays observed on the given date, including
+// weekend holidays moved to it.
+func (c *Calendar) observedHolidays(date time.Time) []Holiday {
+	var hs []Holiday
+	for _, h := range c.HolidaysOn(date) {
+		if h.ownObserved() {
+			if c.sameDay(c.observedDate(&h, date), date) {
+				hs = append(hs, h)
+			}
+		} else if !isMoved(c.Observed, date) {
+			hs = append(hs, h)
+		}
+	}
+	for _, d := range c.observedOn(date) {
+		for _, h := range c.HolidaysOn(d) {
+			if !h.ownObserved() {
+				hs = append(hs, h)
+			}
+		}
+	}
+	if c.customRules == 0 {
+		return hs
+	}
+	for n := -observedWindow; n <= observedWindow; n++ {
+		if n == 0 {
+			continue
+		}
+		d := date.AddDate(0, 0, n)
+		for _, h := range c.HolidaysOn(d) {
+			if h.ownObserved() && c.sameDay(c.observedDate(&h, d), date) {
+				hs = append(hs, h)
+			}
+		}
+	}
+	return hs
+}
+
+// sameDay reports whether a and b fall on the same date in the calendar's
+// Location.
+func (c *Calendar) sameDay(a, b time.Time) bool {
+	a, b = c.local(a), c.local(b)
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// observedDate reports the day on which a holiday falling on the given date is
+// observed, according to its ObservedFunc, its own Observed rule or the
+// calendar's ObservedRule.
+func (c *Calendar) observedDate(h *Holiday, date time.Time) time.Time {
+	if h.ObservedFunc != nil {
+		return h.ObservedFunc(date, c)
+	}
+	rule := c.Observed
+	if h.Observed != nil {
+		rule = *h.Observed
+	} else if h.HalfDay {
+		rule = ObservedExact
+	}
+	return c.observedTarget(rule, date)
+}
+
+// HolidayInstance is the occurrence of a holiday in a year.
+type HolidayInstance struct {
+	Name     string
+	Date     time.Time // the date the holiday falls on
+	Observed time.Time // the date the holiday is observed on
+	Holiday  *Holiday
+}
+
+// Holidays reports every holiday that falls in the given year, in order of
+// date. The observed date of a holiday may be in the year before or after.
+func (c *Calendar) Holidays(year int) []HolidayInstance {
+	var instances []HolidayInstance
+	date := time.Date(year, time.January, 1, 12, 0, 0, 0, c.loc())
+	for ; date.Year() == year; date = date.AddDate(0, 0, 1) {
+		for _, h := range c.HolidaysOn(date) {
+			h := h
+			instances = append(instances, HolidayInstance{
+				Name:     h.displayName(),
+				Date:     date,
+				Observed: c.observedDate(&h, date),
+				Holiday:  &h,
+			})
+		}
+	}
+	return instances
+}
+
+// HolidaySpan is a stretch of consecutive days on which holidays are
+// observed, with the names of the holidays that contribute to it.
+type HolidaySpan struct {
+	Start time.Time
+	End   time.Time
+	Days  int
+	Names []string
+}
+
+// HolidaysInRange reports the stretches of observed holidays between start
+// and end dates, counted the same way as CountWorkdays. Holidays that overlap
+// or follow one another without a gap are merged into a single HolidaySpan;
+// a span is cut short at the edges of the range.
+func (c *Calendar) HolidaysInRange(start, end time.Time, mode ...RangeMode) []HolidaySpan {
+	var spans []HolidaySpan
+	var cur *HolidaySpan
+	first, stop := c.dateRange(start, end, mode)
+	for date := first; date.Before(stop); date = date.AddDate(0, 0, 1) {
+		hs := c.observedHolidays(date)
+		if len(hs) == 0 {
+			cur = nil
+			continue
+		}
+		if cur == nil {
+			spans = append(spans, HolidaySpan{Start: date})
+			cur = &spans[len(spans)-1]
+		}
+		cur.End = date
+		cur.Days++
+		for i := range hs {
+			cur.addName(hs[i].displayName())
+		}
+	}
+	return spans
+}
+
+// addName adds a holiday name to the span unless it is already listed.
+func (s *HolidaySpan) addName(name string) {
+	for _, n := range s.Names {
+		if n == name {
+			return
+		}
+	}
+	s.Names = append(s.Names, name)
+}
+
+// HolidayCount reports the number of distinct dates in the given year on
+// which a holiday is observed. Holidays observed on the same date are counted
+// once.
+func (c *Calendar) HolidayCount(year int) int {
+	start := time.Date(year, time.January, 1, 12, 0, 0, 0, c.loc())
+	n := 0
+	for _, span := range c.HolidaysInRange(start, start.AddDate(1, 0, 0), RangeHalfOpen) {
+		n += span.Days
+	}
+	return n
+}
+
+// HolidayName reports the name of the first holiday that falls on the given
+// date, and whether there is one. A holiday without a Name is reported by its
+// rule. It does not account for the observation of holidays on alternate days.
+func (c *Calendar) HolidayName(date time.Time) (string, bool) {
+	hs := c.HolidaysOn(date)
+	if len(hs) == 0 {
+		return "", false
+	}
+	return hs[0].displayName(), true
+}
+
+// HolidayByName reports a copy of the calendar's holiday with the given name,
+// compared without regard to case. If several holidays share the name, the
+// one in the earliest month wins, holidays without a fixed month (such as
+// those relative to Easter) come last, and ties within a month go to the
+// holiday added first.
+func (c *Calendar) HolidayByName(name string) (*Holiday, bool) {
+	for _, idx := range []time.Month{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 0} {
+		for _, h := range c.holidays[idx] {
+			if strings.EqualFold(h.Name, name) {
+				return &h, true
+			}
 		}
 	}
+	return nil, false
+}
+
+// HasHolidayInRange reports whether a holiday is observed on any date from
+// start to end, inclusive. It stops at the first one found.
+func (c *Calendar) HasHolidayInRange(start, end time.Time) bool {
+	first, stop := c.dateRange(start, end, nil)
+	for date := first; date.Before(stop); date = date.AddDate(0, 0, 1) {
+		if c.isObservedHoliday(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// HolidaysOn reports the holidays of the calendar that fall on the given date.
+// It does not account for the observation of holidays on alternate days.
+func (c *Calendar) HolidaysOn(date time.Time) []Holiday {
+	date = c.local(date)
+	var hs []Holiday
+	for _, idx := range []time.Month{date.Month(), 0} {
+		for i := range c.holidays[idx] {
+			if c.holidays[idx][i].matches(date, c) {
+				hs = append(hs, c.holidays[idx][i])
+			}
+		}
+	}
+	return hs
+}
+
+// ExplainDate reports a human readable explanation of how the calendar treats
+// the given date: whether it falls on a weekend, every holiday that matches it
+// and the rule of each, and any weekend holidays observed on it.
+func (c *Calendar) ExplainDate(date time.Time) string {
+	date = c.local(date)
+	parts := []string{fmt.Sprintf("%s is a %s", date.Format("2006-01-02"), date.Weekday())}
+	if c.IsWeekend(date) {
+		parts = append(parts, "weekend")
+	}
+	for _, h := range c.HolidaysOn(date) {
+		parts = append(parts, "holiday "+h.String())
+	}
+	if !c.IsWeekend(date) && !c.IsHoliday(date) {
+		for _, d := range c.observedOn(date) {
+			for _, h := range c.HolidaysOn(d) {
+				parts = append(parts, fmt.Sprintf("observed holiday %s from %s",
+					h.String(), d.Format("2006-01-02")))
+			}
+		}
+	}
+	if c.IsWorkday(date) {
+		parts = append(parts, "workday")
+	} else {
+		parts = append(parts, "not a workday")
+	}
+	return strings.Join(parts, "; ")
+}
+
+// DayKind is the reason a date is or is not a work day.
+type DayKind int
+
+// DayKind are the specific DayKinds
+const (
+	DayWorkday  DayKind = iota // a work day of the work week
+	DayWeekend                 // a non-working day of the work week
+	DayHoliday                 // a holiday is observed
+	DayOverride                // a non-working day of the work week made a work day
+)
+
+var dayKindNames = [...]string{"workday", "weekend", "holiday", "override"}
+
+// String reports the lower case name of the DayKind.
+func (k DayKind) String() string {
+	if k < 0 || int(k) >= len(dayKindNames) {
+		return fmt.Sprintf("DayKind(%d)", int(k))
+	}
+	return dayKindNames[k]
+}
 
-	return true
+// Classify reports how the calendar treats the given date, and the holiday
+// observed on it, if any. A holiday observed on a weekend day is reported as
+// DayHoliday. A HalfDay holiday leaves the date a work day but is still
+// reported with it. A date that only the calendar's WorkdayFunc makes a
+// non-working day is reported as DayHoliday without a holiday.
+func (c *Calendar) Classify(date time.Time) (DayKind, *Holiday) {
+	date = c.local(date)
+	var h *Holiday
+	if hs := c.observedHolidays(date); len(hs) > 0 {
+		h = &hs[0]
+		for i := range hs {
+			if !hs[i].HalfDay {
+				h = &hs[i]
+				break
+			}
+		}
+	}
+	switch {
+	case c.IsWorkday(date) && c.IsWeekend(date):
+		return DayOverride, h
+	case c.IsWorkday(date):
+		return DayWorkday, h
+	case h != nil && !h.HalfDay:
+		return DayHoliday, h
+	case c.IsWeekend(date):
+		return DayWeekend, nil
+	}
+	return DayHoliday, nil
 }
 
 // countWorkdays reports the number of workdays from the given date to the end
@@ -165,7 +917,7 @@ func (c *Calendar) countWorkdays(dt time.Time, month time.Month) int {
 
 // Workdays reports the total number of workdays for the given year and month.
 func (c *Calendar) Workdays(year int, month time.Month) int {
-	return c.countWorkdays(time.Date(year, month, 1, 12, 0, 0, 0, time.UTC), month)
+	return c.countWorkdays(time.Date(year, month, 1, 12, 0, 0, 0, c.loc()), month)
 }
 
 // WorkdaysRemain reports the total number of remaining workdays in the month
@@ -189,10 +941,10 @@ func (c *Calendar) WorkdayN(year int, month time.Month, n int) int {
 	}
 
 	if n > 0 {
-		date = time.Date(year, month, 1, 12, 0, 0, 0, time.UTC)
+		date = time.Date(year, month, 1, 12, 0, 0, 0, c.loc())
 		add = 1
 	} else {
-		date = time.Date(year, month+1, 1, 12, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+		date = time.Date(year, month+1, 1, 12, 0, 0, 0, c.loc()).AddDate(0, 0, -1)
 		add = -1
 		n = -n
 	}
@@ -209,22 +961,445 @@ func (c *Calendar) WorkdayN(year int, month time.Month, n int) int {
 	return 0
 }
 
-//CountWorkdays return amount of workdays between start and end dates
-func (c *Calendar) CountWorkdays(start, end time.Time) int64 {
-	factor := 1
-	if end.Before(start) {
+// WorkdayOfYear reports the 1-based position of the given date among the
+// workdays of its year, or 0 if the date is not a workday.
+func (c *Calendar) WorkdayOfYear(date time.Time) int {
+	date = c.day(date)
+	if !c.IsWorkday(date) {
+		return 0
+	}
+	n := 0
+	for d := time.Date(date.Year(), time.January, 1, 12, 0, 0, 0, date.Location()); !d.After(date); d = d.AddDate(0, 0, 1) {
+		if c.IsWorkday(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// LongWeekend is a stretch of consecutive non-working days.
+type LongWeekend struct {
+	Start time.Time
+	End   time.Time
+	Days  int
+}
+
+// LongWeekends reports every stretch of three or more consecutive non-working
+// days that begins in the given year. Weekends and holidays (including their
+// observed days) are merged, so a stretch may extend into the following year.
+func (c *Calendar) LongWeekends(year int) []LongWeekend {
+	var spans []LongWeekend
+	date := time.Date(year, time.January, 1, 12, 0, 0, 0, c.loc())
+
+	limit := date.AddDate(0, 0, 2*searchLimit)
+
+	// a stretch carried over from the previous year belongs to that year
+	if !c.IsWorkday(date.AddDate(0, 0, -1)) {
+		for date.Before(limit) && !c.IsWorkday(date) {
+			date = date.AddDate(0, 0, 1)
+		}
+	}
+
+	for date.Year() == year {
+		if c.IsWorkday(date) {
+			date = date.AddDate(0, 0, 1)
+			continue
+		}
+		start := date
+		n := 0
+		for ; date.Before(limit) && !c.IsWorkday(date); date = date.AddDate(0, 0, 1) {
+			n++
+		}
+		if n >= 3 {
+			spans = append(spans, LongWeekend{Start: start, End: date.AddDate(0, 0, -1), Days: n})
+		}
+	}
+	return spans
+}
+
+// searchLimit is the number of days searched for a workday before giving up,
+// such as when every day is a holiday.
+const searchLimit = 366
+
+// BridgeDays reports the workdays of the given year that have non-working
+// days on both sides, at least one of which is a holiday, such as the Friday
+// after a Thursday holiday.
+func (c *Calendar) BridgeDays(year int) []time.Time {
+	var days []time.Time
+	date := time.Date(year, time.January, 1, 12, 0, 0, 0, c.loc())
+	for ; date.Year() == year; date = date.AddDate(0, 0, 1) {
+		if !c.IsWorkday(date) {
+			continue
+		}
+		before, after := date.AddDate(0, 0, -1), date.AddDate(0, 0, 1)
+		if c.IsWorkday(before) || c.IsWorkday(after) {
+			continue
+		}
+		if c.isObservedHoliday(before) || c.isObservedHoliday(after) {
+			days = append(days, date)
+		}
+	}
+	return days
+}
+
+// AddBridgeDaysAsHolidays adds the BridgeDays of the given year to the
+// calendar as holidays named "Bridge day".
+func (c *Calendar) AddBridgeDaysAsHolidays(year int) {
+	for _, date := range c.BridgeDays(year) {
+		h := NewHolidayExact(year, date.Month(), date.Day())
+		h.Name = "Bridge day"
+		c.AddHoliday(h)
+	}
+}
+
+// NextWorkday reports the first workday after the given date, or the zero
+// Time if there is none within a year.
+func (c *Calendar) NextWorkday(date time.Time) time.Time {
+	for i := 0; i < searchLimit; i++ {
+		date = date.AddDate(0, 0, 1)
+		if c.IsWorkday(date) {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
+// PreviousWorkday reports the last workday before the given date, or the zero
+// Time if there is none within a year.
+func (c *Calendar) PreviousWorkday(date time.Time) time.Time {
+	for i := 0; i < searchLimit; i++ {
+		date = date.AddDate(0, 0, -1)
+		if c.IsWorkday(date) {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
+// Direction selects the way NearestWorkday looks first.
+type Direction int
+
+// Direction are the specific Directions
+const (
+	Forward  Direction = iota // later dates are preferred
+	Backward                  // earlier dates are preferred
+)
+
+// NearestWorkday reports the given date if it is a workday, or else the
+// closest workday before or after it. When workdays are equally near, the one
+// in the preferred Direction is chosen. It reports the zero Time if there is
+// none within a year.
+func (c *Calendar) NearestWorkday(date time.Time, prefer Direction) time.Time {
+	if c.IsWorkday(date) {
+		return date
+	}
+	first, second := 1, -1
+	if prefer == Backward {
+		first, second = -1, 1
+	}
+	for i := 1; i <= searchLimit; i++ {
+		if d := date.AddDate(0, 0, first*i); c.IsWorkday(d) {
+			return d
+		}
+		if d := date.AddDate(0, 0, second*i); c.IsWorkday(d) {
+			return d
+		}
+	}
+	return time.Time{}
+}
+
+// NextWorkdayBatch reports the first workday after each of the anchors, in
+// the same order, or the zero Time where there is none within a year. Work
+// days found for one anchor are remembered for the others, so rolling many
+// nearby dates is cheaper than calling NextWorkday for each.
+func (c *Calendar) NextWorkdayBatch(anchors []time.Time) []time.Time {
+	workdays := make(map[dateKey]bool)
+	isWorkday := func(date time.Time) bool {
+		key := c.key(date)
+		workday, ok := workdays[key]
+		if !ok {
+			workday = c.IsWorkday(date)
+			workdays[key] = workday
+		}
+		return workday
+	}
+
+	next := make([]time.Time, len(anchors))
+	for i, date := range anchors {
+		for n := 0; n < searchLimit; n++ {
+			date = date.AddDate(0, 0, 1)
+			if isWorkday(date) {
+				next[i] = date
+				break
+			}
+		}
+	}
+	return next
+}
+
+// WorkdaysFrom reports the date n workdays after start, or before it when n
+// is negative, keeping the time of day of start. A zero n reports start
+// itself, even when it is not a workday. It reports the zero Time if a year
+// passes without a workday.
+func (c *Calendar) WorkdaysFrom(start time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	date := start
+	for gap := 0; n > 0; {
+		date = date.AddDate(0, 0, step)
+		if c.IsWorkday(date) {
+			n--
+			gap = 0
+		} else if gap++; gap >= searchLimit {
+			return time.Time{}
+		}
+	}
+	return date
+}
+
+// lastWorkday reports the last workday from start to end, or the zero Time if
+// there is none.
+func (c *Calendar) lastWorkday(start, end time.Time) time.Time {
+	for date := end; !date.Before(start); date = date.AddDate(0, 0, -1) {
+		if c.IsWorkday(date) {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
+// FirstWorkdayOnOrAfter reports the given date if it is a workday, or else the
+// first workday after it, or the zero Time if there is none within a year.
+func (c *Calendar) FirstWorkdayOnOrAfter(date time.Time) time.Time {
+	if c.IsWorkday(date) {
+		return date
+	}
+	return c.NextWorkday(date)
+}
+
+// FirstWorkdayOfMonth reports the first workday of the given year and month,
+// or the zero Time if the month has no workdays.
+func (c *Calendar) FirstWorkdayOfMonth(year int, month time.Month) time.Time {
+	date := time.Date(year, month, 1, 12, 0, 0, 0, c.loc())
+	for ; date.Month() == month; date = date.AddDate(0, 0, 1) {
+		if c.IsWorkday(date) {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
+// LastWorkdayOfMonth reports the last workday of the given year and month,
+// or the zero Time if the month has no workdays.
+func (c *Calendar) LastWorkdayOfMonth(year int, month time.Month) time.Time {
+	return c.lastWorkday(time.Date(year, month, 1, 12, 0, 0, 0, c.loc()),
+		time.Date(year, month+1, 0, 12, 0, 0, 0, c.loc()))
+}
+
+// LastWorkdayOfQuarter reports the last workday of the given year and quarter
+// (1 to 4), or the zero Time if the quarter has no workdays or is out of
+// range.
+func (c *Calendar) LastWorkdayOfQuarter(year int, quarter int) time.Time {
+	if quarter < 1 || quarter > 4 {
+		return time.Time{}
+	}
+	month := time.Month(quarter*3 - 2)
+	return c.lastWorkday(time.Date(year, month, 1, 12, 0, 0, 0, c.loc()),
+		time.Date(year, month+3, 0, 12, 0, 0, 0, c.loc()))
+}
+
+// LastWorkdayOfYear reports the last workday of the given year, or the zero
+// Time if the year has no workdays.
+func (c *Calendar) LastWorkdayOfYear(year int) time.Time {
+	return c.lastWorkday(time.Date(year, time.January, 1, 12, 0, 0, 0, c.loc()),
+		time.Date(year, time.December, 31, 12, 0, 0, 0, c.loc()))
+}
+
+// RangeMode selects whether the end date of a range is included. Functions
+// taking an optional RangeMode default to RangeClosed. Counts over adjacent
+// half-open ranges add up: counting [a, b) and then [b, c) equals [a, c).
+//
+// When end is before start the dates are swapped first, so a half-open range
+// always includes the earlier date and excludes the later one: counting from
+// b back to a covers [a, b) and is the negative of counting from a to b. This
+// differs from the ExcludeEnd option of WorkdaysBetween, which excludes the
+// end argument whichever date comes first.
+type RangeMode int
+
+// RangeMode are the specific RangeModes
+const (
+	RangeClosed   RangeMode = iota // start and end dates are included
+	RangeHalfOpen                  // start date is included, end date is not
+)
+
+// dateRange reports noon on the first date of the range and on the date after
+// its last, in ascending order. The time portion of start and end is ignored.
+func (c *Calendar) dateRange(start, end time.Time, mode []RangeMode) (first, stop time.Time) {
+	first, stop = c.day(start), c.day(end)
+	if stop.Before(first) {
+		first, stop = stop, first
+	}
+	if len(mode) == 0 || mode[0] == RangeClosed {
+		stop = stop.AddDate(0, 0, 1)
+	}
+	return first, stop
+}
+
+// day reports noon on the date of t in the calendar's Location.
+func (c *Calendar) day(t time.Time) time.Time {
+	t = c.local(t)
+	return time.Date(t.Year(), t.Month(), t.Day(), 12, 0, 0, 0, t.Location())
+}
+
+// CountWorkdays return amount of workdays between start and end dates. The
+// result is negative when end is before start.
+func (c *Calendar) CountWorkdays(start, end time.Time, mode ...RangeMode) int64 {
+	var factor int64 = 1
+	if c.day(end).Before(c.day(start)) {
 		factor = -1
-		start, end = end, start
 	}
-	result := 0
-	var i time.Time
-	for i = start; i.Before(end); i = i.AddDate(0, 0, 1) {
+	first, stop := c.dateRange(start, end, mode)
+	var result int64
+	for i := first; i.Before(stop); i = i.AddDate(0, 0, 1) {
 		if c.IsWorkday(i) {
 			result++
 		}
 	}
-	if i.Equal(end) && c.IsWorkday(end) {
-		result++
+	return factor * result
+}
+
+// EachWorkday calls fn with noon on each workday between start and end dates,
+// in ascending order, until fn returns false. The range is the one counted by
+// CountWorkdays, so with RangeHalfOpen the later of the two dates is left out
+// even when it is start.
+func (c *Calendar) EachWorkday(start, end time.Time, fn func(time.Time) bool, mode ...RangeMode) {
+	first, stop := c.dateRange(start, end, mode)
+	for i := first; i.Before(stop); i = i.AddDate(0, 0, 1) {
+		if c.IsWorkday(i) && !fn(i) {
+			return
+		}
+	}
+}
+
+// CountOption changes which endpoints WorkdaysBetween counts. Options may be
+// combined.
+type CountOption int
+
+// CountOption are the specific CountOptions
+const (
+	ExcludeStart CountOption = 1 << iota // the start date is not counted
+	ExcludeEnd                           // the end date is not counted
+)
+
+// WorkdaysBetween reports the number of workdays from start to end. Both
+// dates are counted unless excluded by an option, and the result is negative
+// when end is before start, as in the NETWORKDAYS function of spreadsheets.
+// When start and end fall on the same date, excluding either endpoint
+// excludes that date. Unlike RangeHalfOpen, ExcludeEnd leaves out the end
+// argument even when it is the earlier date.
+func (c *Calendar) WorkdaysBetween(start, end time.Time, opts ...CountOption) int {
+	var opt CountOption
+	for _, o := range opts {
+		opt |= o
+	}
+	sign := 1
+	if c.day(end).Before(c.day(start)) {
+		sign = -1
+	}
+	n := sign * int(c.CountWorkdays(start, end))
+	if opt&ExcludeStart != 0 && c.IsWorkday(start) {
+		n--
+	}
+	if opt&ExcludeEnd != 0 && c.IsWorkday(end) &&
+		(opt&ExcludeStart == 0 || !c.sameDay(start, end)) {
+		n--
+	}
+	return sign * n
+}
+
+// WorkdayFraction reports the ratio of workdays to calendar days between
+// start and end dates, counted the same way as CountWorkdays. An empty range,
+// or one where end is before start, reports 0.
+func (c *Calendar) WorkdayFraction(start, end time.Time, mode ...RangeMode) float64 {
+	if c.day(end).Before(c.day(start)) {
+		return 0
+	}
+	first, stop := c.dateRange(start, end, mode)
+	days := 0
+	for i := first; i.Before(stop); i = i.AddDate(0, 0, 1) {
+		days++
+	}
+	if days == 0 {
+		return 0
+	}
+	return float64(c.CountWorkdays(start, end, mode...)) / float64(days)
+}
+
+// WorkFraction reports the share of a full work day that is worked on the
+// given date: 0 for a non-working day, 0.5 for a HalfDay holiday and 1
+// otherwise.
+func (c *Calendar) WorkFraction(date time.Time) float64 {
+	if !c.IsWorkday(date) {
+		return 0
+	}
+	if c.customRules > 0 {
+		for _, h := range c.observedHolidays(c.local(date)) {
+			if h.HalfDay {
+				return 0.5
+			}
+		}
+	}
+	return 1
+}
+
+// WorkHours reports the number of working hours between start and end dates,
+// counted the same way as CountWorkdays, for work days of hoursPerDay hours.
+// HalfDay holidays count for half of a day.
+func (c *Calendar) WorkHours(start, end time.Time, hoursPerDay float64, mode ...RangeMode) float64 {
+	first, stop := c.dateRange(start, end, mode)
+	var days float64
+	for i := first; i.Before(stop); i = i.AddDate(0, 0, 1) {
+		days += c.WorkFraction(i)
+	}
+	return days * hoursPerDay
+}
+
+// HolidayChange describes a holiday that comes into or goes out of effect.
+// IntroducedYear is the first year with the holiday and AbolishedYear is the
+// first year without it; either is 0 when that change is not reported.
+type HolidayChange struct {
+	Holiday        *Holiday
+	IntroducedYear int
+	AbolishedYear  int
+}
+
+// HolidayChanges reports the holidays of the calendar that are introduced or
+// abolished from startYear to endYear (inclusive), based on their ValidFrom
+// and ValidTo years. Holidays valid for a single year, such as those created
+// by NewHolidayExact, bridge days and day transfers, are one-off days rather
+// than changes and are not reported.
+func (c *Calendar) HolidayChanges(startYear, endYear int) []HolidayChange {
+	var changes []HolidayChange
+	for idx := range c.holidays {
+		for _, h := range c.holidays[idx] {
+			if h.ValidFrom != 0 && h.ValidFrom == h.ValidTo {
+				continue
+			}
+			var change HolidayChange
+			if h.ValidFrom >= startYear && h.ValidFrom <= endYear {
+				change.IntroducedYear = h.ValidFrom
+			}
+			if h.ValidTo > 0 && h.ValidTo+1 >= startYear && h.ValidTo+1 <= endYear {
+				change.AbolishedYear = h.ValidTo + 1
+			}
+			if change.IntroducedYear > 0 || change.AbolishedYear > 0 {
+				h := h
+				change.Holiday = &h
+				changes = append(changes, change)
+			}
+		}
 	}
-	return int64(factor * result)
+	return changes
 }
@@ -3,6 +3,8 @@
 package cal
 
 import (
+	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -96,6 +98,63 @@ func TestMonthEnd(t *testing.T) {
 	}
 }
 
+func TestWeekdayOnOrAfter(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		d    time.Weekday
+		want time.Time
+	}{
+		{time.Date(2016, 11, 2, 12, 0, 0, 0, time.UTC), time.Tuesday, time.Date(2016, 11, 8, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2016, 11, 8, 12, 0, 0, 0, time.UTC), time.Tuesday, time.Date(2016, 11, 8, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 30, 0, 0, 0, 0, time.UTC), time.Monday, time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got := WeekdayOnOrAfter(test.t, test.d)
+		if got != test.want {
+			t.Errorf("got: %s; want: %s (%s, %d)", got, test.want, test.t, test.d)
+		}
+	}
+}
+
+func TestWeekdayOnOrBefore(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		d    time.Weekday
+		want time.Time
+	}{
+		{time.Date(2017, 5, 24, 12, 0, 0, 0, time.UTC), time.Monday, time.Date(2017, 5, 22, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2021, 5, 24, 12, 0, 0, 0, time.UTC), time.Monday, time.Date(2021, 5, 24, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), time.Friday, time.Date(2016, 12, 30, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got := WeekdayOnOrBefore(test.t, test.d)
+		if got != test.want {
+			t.Errorf("got: %s; want: %s (%s, %d)", got, test.want, test.t, test.d)
+		}
+	}
+}
+
+func TestWeekdayBefore(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		d    time.Weekday
+		want time.Time
+	}{
+		{time.Date(2024, 11, 23, 12, 0, 0, 0, time.UTC), time.Wednesday, time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2022, 11, 23, 12, 0, 0, 0, time.UTC), time.Wednesday, time.Date(2022, 11, 16, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 25, 0, 0, 0, 0, time.UTC), time.Sunday, time.Date(2016, 12, 18, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got := WeekdayBefore(test.t, test.d)
+		if got != test.want {
+			t.Errorf("got: %s; want: %s (%s, %d)", got, test.want, test.t, test.d)
+		}
+	}
+}
+
 func TestJulianDayNumber(t *testing.T) {
 	tests := []struct {
 		t    time.Time
@@ -156,6 +215,65 @@ func TestCalculateEaster(t *testing.T) {
 	}
 }
 
+func TestCalculateOrthodoxEaster(t *testing.T) {
+	tests := []time.Time{
+		time.Date(2016, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2017, 4, 16, 0, 0, 0, 0, time.UTC),
+		time.Date(2018, 4, 8, 0, 0, 0, 0, time.UTC),
+		time.Date(2019, 4, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 4, 19, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 5, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, test := range tests {
+		got := calculateOrthodoxEaster(test.Year(), test.Location())
+		if got != test {
+			t.Errorf("got: %s; want: %s", got, test)
+		}
+	}
+}
+
+func TestEasterYearDay(t *testing.T) {
+	for year := 1900; year <= 2200; year++ {
+		for _, m := range []EasterMethod{EasterGregorian, EasterJulian} {
+			want := m.easter(year, time.UTC).YearDay()
+			if got := m.easterYearDay(year); got != want {
+				t.Errorf("got: %d; want: %d (%d, %d)", got, want, year, m)
+			}
+		}
+	}
+}
+
+func BenchmarkEasterOffset(b *testing.B) {
+	c := NewGermanCalendar()
+	start := time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+	end := start.AddDate(100, 0, 0)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for date := start; date.Before(end); date = date.AddDate(0, 0, 7) {
+			DE_KarFreitag.matches(date, c)
+			DE_Pfingstmontag.matches(date, c)
+		}
+	}
+}
+
+func TestEasterMethod(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(ECB_GoodFriday)
+
+	western := time.Date(2016, 3, 25, 12, 0, 0, 0, time.UTC)
+	orthodox := time.Date(2016, 4, 29, 12, 0, 0, 0, time.UTC)
+
+	if !c.IsHoliday(western) || c.IsHoliday(orthodox) {
+		t.Errorf("Expected Good Friday on %s with the Gregorian method", western)
+	}
+	c.EasterMethod = EasterJulian
+	if c.IsHoliday(western) || !c.IsHoliday(orthodox) {
+		t.Errorf("Expected Good Friday on %s with the Julian method", orthodox)
+	}
+}
+
 func TestCalculateGoodFriday(t *testing.T) {
 	c := NewCalendar()
 	c.AddHoliday(ECB_GoodFriday)
@@ -268,6 +386,35 @@ func TestWorkdayNearest(t *testing.T) {
 	}
 }
 
+func TestWorkdayNearestEachWeekday(t *testing.T) {
+	// August 1st 2016 is a Monday
+	tests := []struct {
+		holiday  int // day of August
+		observed int // day of August that is not a work day as a result
+	}{
+		{1, 1}, // Monday
+		{2, 2}, // Tuesday
+		{3, 3}, // Wednesday
+		{4, 4}, // Thursday
+		{5, 5}, // Friday
+		{6, 5}, // Saturday is observed on Friday
+		{7, 8}, // Sunday is observed on Monday
+	}
+
+	for _, test := range tests {
+		c := NewCalendar()
+		c.AddHoliday(NewHoliday(time.August, test.holiday))
+
+		for day := -2; day <= 9; day++ {
+			date := time.Date(2016, 8, day, 12, 0, 0, 0, time.UTC)
+			want := !IsWeekend(date) && day != test.observed
+			if got := c.IsWorkday(date); got != want {
+				t.Errorf("got: %t; want: %t (%s, holiday on August %d)", got, want, date, test.holiday)
+			}
+		}
+	}
+}
+
 func TestWorkdayExact(t *testing.T) {
 	c := NewCalendar()
 	c.Observed = ObservedExact
@@ -403,34 +550,48 @@ func TestWorkdayN(t *testing.T) {
 	}
 }
 
-func TestGermanHolidays(t *testing.T) {
-	c := NewCalendar()
-	c.Observed = ObservedExact
-	AddGermanHolidays(c)
-
+func TestGermanStateHolidays(t *testing.T) {
 	tests := []struct {
-		t    time.Time
-		want bool
+		state string
+		t     time.Time
+		want  bool
 	}{
-		{time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC), true},   // Neujahr
-		{time.Date(2016, 3, 25, 12, 0, 0, 0, time.UTC), true},  // Karfreitag
-		{time.Date(2016, 3, 28, 12, 0, 0, 0, time.UTC), true},  // Ostermontag
-		{time.Date(2016, 5, 1, 12, 0, 0, 0, time.UTC), true},   // Tag der Arbeit
-		{time.Date(2016, 5, 5, 12, 0, 0, 0, time.UTC), true},   // Himmelfahrt
-		{time.Date(2000, 6, 1, 12, 0, 0, 0, time.UTC), true},   // Himmelfahrt
-		{time.Date(2016, 5, 16, 12, 0, 0, 0, time.UTC), true},  // Pfingstmontag
-		{time.Date(2000, 6, 12, 12, 0, 0, 0, time.UTC), true},  // Pfingstmontag
-		{time.Date(2016, 10, 3, 12, 0, 0, 0, time.UTC), true},  // Tag der deutschen Einheit
-		{time.Date(2016, 12, 25, 12, 0, 0, 0, time.UTC), true}, // 1. Weihnachtstag
-		{time.Date(2016, 12, 26, 12, 0, 0, 0, time.UTC), true}, // 2. Weihnachtstag
+		{"BY", time.Date(2016, 1, 6, 12, 0, 0, 0, time.UTC), true},    // Heilige Drei Könige
+		{"BY", time.Date(2016, 5, 26, 12, 0, 0, 0, time.UTC), true},   // Fronleichnam
+		{"BY", time.Date(2016, 8, 15, 12, 0, 0, 0, time.UTC), true},   // Mariä Himmelfahrt
+		{"BY", time.Date(2016, 11, 1, 12, 0, 0, 0, time.UTC), true},   // Allerheiligen
+		{"BY", time.Date(2016, 10, 31, 12, 0, 0, 0, time.UTC), false}, // Reformationstag
+		{"BY", time.Date(2016, 11, 16, 12, 0, 0, 0, time.UTC), false}, // Buß- und Bettag
+		{"BY", time.Date(2016, 10, 3, 12, 0, 0, 0, time.UTC), true},   // Tag der deutschen Einheit
+		{"SN", time.Date(2016, 10, 31, 12, 0, 0, 0, time.UTC), true},  // Reformationstag
+		{"SN", time.Date(2016, 11, 16, 12, 0, 0, 0, time.UTC), true},  // Buß- und Bettag
+		{"SN", time.Date(2017, 11, 22, 12, 0, 0, 0, time.UTC), true},  // Buß- und Bettag
+		{"SN", time.Date(2017, 11, 15, 12, 0, 0, 0, time.UTC), false},
+		{"SN", time.Date(2022, 11, 16, 12, 0, 0, 0, time.UTC), true}, // Buß- und Bettag (November 23rd is a Wednesday)
+		{"SN", time.Date(2022, 11, 23, 12, 0, 0, 0, time.UTC), false},
+		{"SN", time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC), true},  // Buß- und Bettag
+		{"SN", time.Date(2016, 5, 26, 12, 0, 0, 0, time.UTC), false},  // Fronleichnam
+		{"SN", time.Date(2016, 12, 26, 12, 0, 0, 0, time.UTC), true},  // 2. Weihnachtstag
+		{"NI", time.Date(2017, 10, 31, 12, 0, 0, 0, time.UTC), false}, // Reformationstag (from 2018)
+		{"NI", time.Date(2018, 10, 31, 12, 0, 0, 0, time.UTC), true},
+		{"BE", time.Date(2019, 3, 8, 12, 0, 0, 0, time.UTC), true}, // Frauentag
 	}
 
 	for _, test := range tests {
+		c := NewCalendar()
+		c.Observed = ObservedExact
+		if err := AddGermanStateHolidays(c, test.state); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
 		got := c.IsHoliday(test.t)
 		if got != test.want {
-			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+			t.Errorf("got: %t; want: %t (%s, %s)", got, test.want, test.state, test.t)
 		}
 	}
+
+	if err := AddGermanStateHolidays(NewCalendar(), "XX"); err == nil {
+		t.Errorf("Expected an error for an unknown state")
+	}
 }
 
 func TestDutchHolidays(t *testing.T) {
@@ -504,3 +665,1213 @@ func TestCountWorkdays(t *testing.T) {
 		}
 	}
 }
+
+func TestBridgeDays(t *testing.T) {
+	c := NewGermanCalendar()
+
+	got := c.BridgeDays(2017)
+	want := []time.Time{
+		time.Date(2017, 5, 26, 12, 0, 0, 0, time.UTC), // after Christi Himmelfahrt
+		time.Date(2017, 10, 2, 12, 0, 0, 0, time.UTC), // before Tag der Deutschen Einheit
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; want: %v", got, want)
+	}
+
+	c.AddBridgeDaysAsHolidays(2017)
+	for _, date := range want {
+		if c.IsWorkday(date) {
+			t.Errorf("Expected %s to be a holiday", date)
+		}
+		if name, _ := c.HolidayName(date); name != "Bridge day" {
+			t.Errorf("got: %q; want: %q", name, "Bridge day")
+		}
+	}
+	if got := c.BridgeDays(2017); len(got) != 0 {
+		t.Errorf("got: %v; want: no bridge days", got)
+	}
+	if !c.IsWorkday(time.Date(2018, 10, 2, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Did not expect a bridge day in 2018")
+	}
+}
+
+func TestWorkdayOfYear(t *testing.T) {
+	c := NewUSCalendar()
+
+	tests := []struct {
+		t    time.Time
+		want int
+	}{
+		{time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC), 0}, // New Year's Day
+		{time.Date(2016, 1, 3, 12, 0, 0, 0, time.UTC), 0}, // Sunday
+		{time.Date(2016, 1, 4, 12, 0, 0, 0, time.UTC), 1},
+		{time.Date(2016, 1, 19, 12, 0, 0, 0, time.UTC), 11}, // after Martin Luther King Jr. Day
+		{time.Date(2016, 6, 30, 12, 0, 0, 0, time.UTC), 126},
+		{time.Date(2016, 12, 30, 12, 0, 0, 0, time.UTC), 251},
+	}
+
+	for _, test := range tests {
+		got := c.WorkdayOfYear(test.t)
+		if got != test.want {
+			t.Errorf("got: %d; want: %d (%s)", got, test.want, test.t)
+		}
+	}
+
+	// Saturday becomes the first workday of the year
+	c.SetWorkday(time.Saturday, true)
+	if got := c.WorkdayOfYear(time.Date(2016, 1, 2, 12, 0, 0, 0, time.UTC)); got != 1 {
+		t.Errorf("got: %d; want: 1", got)
+	}
+}
+
+func TestLongWeekends(t *testing.T) {
+	c := NewCalendar()
+	c.Observed = ObservedMonday
+	AddBritishHolidays(c)
+
+	want := []LongWeekend{
+		{time.Date(2017, 4, 14, 12, 0, 0, 0, time.UTC), time.Date(2017, 4, 17, 12, 0, 0, 0, time.UTC), 4},
+		{time.Date(2017, 4, 29, 12, 0, 0, 0, time.UTC), time.Date(2017, 5, 1, 12, 0, 0, 0, time.UTC), 3},
+		{time.Date(2017, 5, 27, 12, 0, 0, 0, time.UTC), time.Date(2017, 5, 29, 12, 0, 0, 0, time.UTC), 3},
+		{time.Date(2017, 8, 26, 12, 0, 0, 0, time.UTC), time.Date(2017, 8, 28, 12, 0, 0, 0, time.UTC), 3},
+		{time.Date(2017, 12, 23, 12, 0, 0, 0, time.UTC), time.Date(2017, 12, 26, 12, 0, 0, 0, time.UTC), 4},
+		{time.Date(2017, 12, 30, 12, 0, 0, 0, time.UTC), time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC), 3},
+	}
+
+	got := c.LongWeekends(2017)
+	if len(got) != len(want) {
+		t.Fatalf("got: %d long weekends; want: %d (%v)", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got: %v; want: %v", got[i], want[i])
+		}
+	}
+}
+
+func TestCountWorkdaysRangeMode(t *testing.T) {
+	c := NewUSCalendar()
+
+	start := time.Date(2015, 12, 17, 12, 0, 0, 0, time.UTC)
+	end := time.Date(2015, 12, 21, 8, 0, 0, 0, time.UTC)
+	if got := c.CountWorkdays(start, end); got != 3 {
+		t.Errorf("got: %d; want: 3 (closed)", got)
+	}
+	if got := c.CountWorkdays(start, end, RangeClosed); got != 3 {
+		t.Errorf("got: %d; want: 3 (closed)", got)
+	}
+	if got := c.CountWorkdays(start, end, RangeHalfOpen); got != 2 {
+		t.Errorf("got: %d; want: 2 (half-open)", got)
+	}
+	if got := c.CountWorkdays(end, start, RangeHalfOpen); got != -2 {
+		t.Errorf("got: %d; want: -2 (half-open)", got)
+	}
+	if got := c.CountWorkdays(start, start, RangeHalfOpen); got != 0 {
+		t.Errorf("got: %d; want: 0 (half-open)", got)
+	}
+	if got := c.WorkdayFraction(start, start, RangeHalfOpen); got != 0 {
+		t.Errorf("got: %v; want: 0 (half-open)", got)
+	}
+}
+
+func TestEachWorkday(t *testing.T) {
+	c := NewUSCalendar()
+
+	start := time.Date(2015, 12, 17, 8, 0, 0, 0, time.UTC)
+	end := time.Date(2015, 12, 28, 18, 0, 0, 0, time.UTC)
+	want := []time.Time{
+		time.Date(2015, 12, 17, 12, 0, 0, 0, time.UTC),
+		time.Date(2015, 12, 18, 12, 0, 0, 0, time.UTC),
+		time.Date(2015, 12, 21, 12, 0, 0, 0, time.UTC),
+		time.Date(2015, 12, 22, 12, 0, 0, 0, time.UTC),
+		time.Date(2015, 12, 23, 12, 0, 0, 0, time.UTC),
+		time.Date(2015, 12, 24, 12, 0, 0, 0, time.UTC),
+		time.Date(2015, 12, 28, 12, 0, 0, 0, time.UTC),
+	}
+
+	var got []time.Time
+	c.EachWorkday(start, end, func(d time.Time) bool {
+		got = append(got, d)
+		return true
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; want: %v", got, want)
+	}
+
+	// the later date is left out even though it is passed as start
+	got = nil
+	c.EachWorkday(end, start, func(d time.Time) bool {
+		got = append(got, d)
+		return true
+	}, RangeHalfOpen)
+	if !reflect.DeepEqual(got, want[:6]) {
+		t.Errorf("got: %v; want: %v (half-open)", got, want[:6])
+	}
+
+	got = nil
+	c.EachWorkday(start, end, func(d time.Time) bool {
+		got = append(got, d)
+		return len(got) < 3
+	})
+	if !reflect.DeepEqual(got, want[:3]) {
+		t.Errorf("got: %v; want: %v (stopped)", got, want[:3])
+	}
+}
+
+func TestWorkdaysBetween(t *testing.T) {
+	c := NewUSCalendar()
+
+	thu := time.Date(2015, 12, 17, 12, 0, 0, 0, time.UTC)
+	sat := time.Date(2015, 12, 19, 12, 0, 0, 0, time.UTC)
+	mon := time.Date(2015, 12, 21, 8, 0, 0, 0, time.UTC)
+	christmas := time.Date(2015, 12, 25, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		t    time.Time
+		u    time.Time
+		opts []CountOption
+		want int
+	}{
+		{thu, mon, nil, 3},
+		{mon, thu, nil, -3},
+		{thu, mon, []CountOption{ExcludeStart}, 2},
+		{thu, mon, []CountOption{ExcludeEnd}, 2},
+		{thu, mon, []CountOption{ExcludeStart, ExcludeEnd}, 1},
+		{mon, thu, []CountOption{ExcludeStart, ExcludeEnd}, -1},
+		{mon, thu, []CountOption{ExcludeStart}, -2},
+		{sat, mon, []CountOption{ExcludeStart}, 1},
+		{thu, thu, nil, 1},
+		{thu, thu, []CountOption{ExcludeStart}, 0},
+		{thu, thu, []CountOption{ExcludeStart, ExcludeEnd}, 0},
+		{sat, sat, nil, 0},
+		{mon, christmas, nil, 4},
+		{christmas, mon, []CountOption{ExcludeStart}, -4},
+	}
+
+	for _, test := range tests {
+		got := c.WorkdaysBetween(test.t, test.u, test.opts...)
+		if got != test.want {
+			t.Errorf("got: %d; want: %d (%s-%s %v)", got, test.want, test.t, test.u, test.opts)
+		}
+	}
+}
+
+func TestCountWorkdaysAdditive(t *testing.T) {
+	c := NewUSCalendar()
+	base := time.Date(2015, 12, 1, 12, 0, 0, 0, time.UTC)
+
+	// every combination of a, b and c within two months, in any order
+	for i := 0; i < 60; i += 3 {
+		for j := 0; j < 60; j += 5 {
+			for k := 0; k < 60; k += 7 {
+				a, b, d := base.AddDate(0, 0, i), base.AddDate(0, 0, j), base.AddDate(0, 0, k)
+
+				ab := c.CountWorkdays(a, b, RangeHalfOpen)
+				bd := c.CountWorkdays(b, d, RangeHalfOpen)
+				ad := c.CountWorkdays(a, d, RangeHalfOpen)
+				if ab+bd != ad {
+					t.Errorf("half-open: %d + %d != %d (%s, %s, %s)", ab, bd, ad, a, b, d)
+				}
+
+				if !a.After(b) && !b.After(d) {
+					ab = c.CountWorkdays(a, b, RangeClosed)
+					bd = c.CountWorkdays(b, d, RangeClosed)
+					ad = c.CountWorkdays(a, d, RangeClosed)
+					if c.IsWorkday(b) {
+						ad++
+					}
+					if ab+bd != ad {
+						t.Errorf("closed: %d + %d != %d (%s, %s, %s)", ab, bd, ad, a, b, d)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestWorkdayFraction(t *testing.T) {
+	us := NewUSCalendar()
+	gb := NewBritishCalendar()
+
+	tests := []struct {
+		c    *Calendar
+		t    time.Time
+		u    time.Time
+		want float64
+	}{
+		{us, time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC), time.Date(2017, 12, 31, 12, 0, 0, 0, time.UTC), 250.0 / 365.0},
+		{gb, time.Date(2017, 12, 25, 12, 0, 0, 0, time.UTC), time.Date(2017, 12, 31, 12, 0, 0, 0, time.UTC), 3.0 / 7.0},
+		{gb, time.Date(2017, 12, 25, 12, 0, 0, 0, time.UTC), time.Date(2017, 12, 25, 12, 0, 0, 0, time.UTC), 0},
+		{gb, time.Date(2017, 12, 27, 12, 0, 0, 0, time.UTC), time.Date(2017, 12, 27, 12, 0, 0, 0, time.UTC), 1},
+		{gb, time.Date(2017, 12, 27, 12, 0, 0, 0, time.UTC), time.Date(2017, 12, 26, 12, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, test := range tests {
+		got := test.c.WorkdayFraction(test.t, test.u)
+		if got != test.want {
+			t.Errorf("got: %v; want: %v (%s-%s)", got, test.want, test.t, test.u)
+		}
+	}
+}
+
+func TestCalendarLocation(t *testing.T) {
+	tz, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("unable to load time zone: %v", err)
+	}
+
+	c := NewCalendar()
+	c.Observed = ObservedExact
+	c.AddHoliday(US_NewYear)
+
+	newYearsEve := time.Date(2016, 12, 31, 23, 0, 0, 0, time.UTC) // midnight in Berlin
+	if c.IsHoliday(newYearsEve) {
+		t.Errorf("Did not expect %q to be a holiday without a location", newYearsEve)
+	}
+
+	c.Location = tz
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{newYearsEve, true},
+		{time.Date(2016, 12, 31, 22, 59, 0, 0, time.UTC), false},
+		{time.Date(2017, 1, 1, 22, 59, 0, 0, time.UTC), true},
+		{time.Date(2017, 1, 1, 23, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	if got := c.Workdays(2017, time.January); got != 22 {
+		t.Errorf("got: %d; want: %d workdays", got, 22)
+	}
+}
+
+func TestExplainDate(t *testing.T) {
+	c := NewUSCalendar()
+	c.AddHoliday(Holiday{Name: "Parade", Month: time.July, Day: 4})
+
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{time.Date(2015, 7, 3, 12, 0, 0, 0, time.UTC), "2015-07-03 is a Friday; " +
+			"observed holiday Independence Day (July 4) from 2015-07-04; " +
+			"observed holiday Parade (July 4) from 2015-07-04; not a workday"},
+		{time.Date(2015, 7, 4, 12, 0, 0, 0, time.UTC), "2015-07-04 is a Saturday; weekend; " +
+			"holiday Independence Day (July 4); holiday Parade (July 4); not a workday"},
+		{time.Date(2015, 5, 25, 12, 0, 0, 0, time.UTC), "2015-05-25 is a Monday; " +
+			"holiday Memorial Day (last Monday of May); not a workday"},
+		{time.Date(2015, 7, 6, 12, 0, 0, 0, time.UTC), "2015-07-06 is a Monday; workday"},
+	}
+
+	for _, test := range tests {
+		got := c.ExplainDate(test.t)
+		if got != test.want {
+			t.Errorf("got: %q; want: %q", got, test.want)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	c := NewUSCalendar()
+	c.AddHoliday(Holiday{Name: "Christmas Eve", Month: time.December, Day: 24, HalfDay: true})
+	c.AddWorkdayOverride(time.Date(2015, 12, 19, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		t       time.Time
+		want    DayKind
+		holiday string
+	}{
+		{time.Date(2015, 12, 18, 12, 0, 0, 0, time.UTC), DayWorkday, ""},
+		{time.Date(2015, 12, 19, 12, 0, 0, 0, time.UTC), DayOverride, ""},
+		{time.Date(2015, 12, 20, 12, 0, 0, 0, time.UTC), DayWeekend, ""},
+		{time.Date(2015, 12, 24, 12, 0, 0, 0, time.UTC), DayWorkday, "Christmas Eve"},
+		{time.Date(2015, 12, 25, 12, 0, 0, 0, time.UTC), DayHoliday, "Christmas Day"},
+		{time.Date(2016, 12, 25, 12, 0, 0, 0, time.UTC), DayWeekend, ""},
+		{time.Date(2016, 12, 26, 12, 0, 0, 0, time.UTC), DayHoliday, "Christmas Day"},
+	}
+
+	for _, test := range tests {
+		got, h := c.Classify(test.t)
+		name := ""
+		if h != nil {
+			name = h.Name
+		}
+		if got != test.want || name != test.holiday {
+			t.Errorf("got: %s %q; want: %s %q (%s)", got, name, test.want, test.holiday, test.t)
+		}
+	}
+
+	c.WorkdayFunc = func(c *Calendar, date time.Time) bool { return false }
+	if got, h := c.Classify(time.Date(2015, 12, 18, 12, 0, 0, 0, time.UTC)); got != DayHoliday || h != nil {
+		t.Errorf("got: %s %v; want: %s <nil>", got, h, DayHoliday)
+	}
+}
+
+func TestLastWorkday(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(NewHoliday(time.December, 31))
+
+	// December 31st 2016 is a Saturday, observed on Friday the 30th
+	want := time.Date(2016, 12, 29, 12, 0, 0, 0, time.UTC)
+	if got := c.LastWorkdayOfMonth(2016, time.December); got != want {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+	if got := c.LastWorkdayOfQuarter(2016, 4); got != want {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+	if got := c.LastWorkdayOfYear(2016); got != want {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+
+	tests := []struct {
+		y    int
+		m    time.Month
+		want time.Time
+	}{
+		{2016, time.April, time.Date(2016, 4, 29, 12, 0, 0, 0, time.UTC)},
+		{2016, time.February, time.Date(2016, 2, 29, 12, 0, 0, 0, time.UTC)},
+		{2017, time.December, time.Date(2017, 12, 29, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		if got := c.LastWorkdayOfMonth(test.y, test.m); got != test.want {
+			t.Errorf("got: %s; want: %s (%d %d)", got, test.want, test.y, test.m)
+		}
+	}
+
+	want = time.Date(2016, 6, 30, 12, 0, 0, 0, time.UTC)
+	if got := c.LastWorkdayOfQuarter(2016, 2); got != want {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+	for _, quarter := range []int{0, 5, -1} {
+		if got := c.LastWorkdayOfQuarter(2016, quarter); !got.IsZero() {
+			t.Errorf("got: %s; want the zero Time (quarter %d)", got, quarter)
+		}
+	}
+}
+
+func TestFirstWorkday(t *testing.T) {
+	c := NewUSCalendar()
+
+	tests := []struct {
+		y    int
+		m    time.Month
+		want time.Time
+	}{
+		{2016, time.January, time.Date(2016, 1, 4, 12, 0, 0, 0, time.UTC)},
+		{2017, time.January, time.Date(2017, 1, 3, 12, 0, 0, 0, time.UTC)},
+		{2016, time.February, time.Date(2016, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{2015, time.August, time.Date(2015, 8, 3, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		if got := c.FirstWorkdayOfMonth(test.y, test.m); got != test.want {
+			t.Errorf("got: %s; want: %s (%d %d)", got, test.want, test.y, test.m)
+		}
+	}
+
+	dates := []struct {
+		t    time.Time
+		want time.Time
+	}{
+		{time.Date(2015, 7, 1, 8, 30, 0, 0, time.UTC), time.Date(2015, 7, 1, 8, 30, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 3, 8, 30, 0, 0, time.UTC), time.Date(2015, 7, 6, 8, 30, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 24, 12, 0, 0, 0, time.UTC), time.Date(2016, 12, 27, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range dates {
+		if got := c.FirstWorkdayOnOrAfter(test.t); got != test.want {
+			t.Errorf("got: %s; want: %s (%s)", got, test.want, test.t)
+		}
+	}
+
+	c.WorkdayFunc = func(c *Calendar, date time.Time) bool { return false }
+	if got := c.FirstWorkdayOfMonth(2016, time.January); !got.IsZero() {
+		t.Errorf("got: %s; want: zero Time", got)
+	}
+}
+
+func TestWeekmask(t *testing.T) {
+	c := NewCalendar()
+	c.Observed = ObservedExact
+	c.AddHoliday(US_NewYear)
+
+	if err := c.SetWeekmask([7]bool{}); err == nil {
+		t.Errorf("Expected an error for a weekmask without work days")
+	}
+	err := c.SetWeekmask([7]bool{
+		time.Tuesday:   true,
+		time.Wednesday: true,
+		time.Thursday:  true,
+		time.Friday:    true,
+		time.Saturday:  true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		t    time.Time
+		u    time.Time
+		want int64
+	}{
+		{time.Date(2015, 12, 17, 12, 0, 0, 0, time.UTC), time.Date(2015, 12, 20, 12, 0, 0, 0, time.UTC), 3},
+		{time.Date(2015, 12, 21, 12, 0, 0, 0, time.UTC), time.Date(2015, 12, 27, 12, 0, 0, 0, time.UTC), 5},
+		{time.Date(2015, 12, 28, 12, 0, 0, 0, time.UTC), time.Date(2016, 1, 3, 12, 0, 0, 0, time.UTC), 4},
+	}
+
+	for _, test := range tests {
+		got := c.CountWorkdays(test.t, test.u)
+		if got != test.want {
+			t.Errorf("got: %d; want: %d (%s-%s)", got, test.want, test.t, test.u)
+		}
+	}
+
+	c.SetWorkday(time.Saturday, false)
+	if got := c.CountWorkdays(time.Date(2015, 12, 21, 12, 0, 0, 0, time.UTC),
+		time.Date(2015, 12, 27, 12, 0, 0, 0, time.UTC)); got != 4 {
+		t.Errorf("got: %d; want: 4", got)
+	}
+}
+
+func TestWeekmaskFrom(t *testing.T) {
+	c := NewCalendar()
+	if err := c.SetWeekmaskFrom(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), [7]bool{}); err == nil {
+		t.Errorf("Expected an error for a weekmask without work days")
+	}
+	c.SetWeekmask([7]bool{true, true, true, true, true, false, false})
+	c.SetWeekmaskFrom(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), [7]bool{false, true, true, true, true, true, false})
+	c.SetWeekmaskFrom(time.Date(2006, 9, 1, 0, 0, 0, 0, time.UTC), [7]bool{true, true, true, true, false, false, true})
+	// replaces the change on the same date
+	c.SetWeekmaskFrom(time.Date(2006, 9, 1, 0, 0, 0, 0, time.UTC), [7]bool{true, true, true, true, true, false, false})
+	c.SetWeekmaskFrom(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), [7]bool{true, true, true, true, false, false, true})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(1999, 12, 30, 12, 0, 0, 0, time.UTC), true},  // Thursday before any change
+		{time.Date(2006, 8, 31, 12, 0, 0, 0, time.UTC), false},  // Thursday
+		{time.Date(2006, 9, 2, 12, 0, 0, 0, time.UTC), false},   // Saturday
+		{time.Date(2006, 9, 7, 12, 0, 0, 0, time.UTC), true},    // Thursday
+		{time.Date(2021, 12, 31, 12, 0, 0, 0, time.UTC), false}, // Friday
+		{time.Date(2022, 1, 2, 12, 0, 0, 0, time.UTC), false},   // Sunday
+		{time.Date(2022, 1, 7, 12, 0, 0, 0, time.UTC), true},    // Friday
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestNextWorkday(t *testing.T) {
+	c := NewUSCalendar()
+
+	tests := []struct {
+		t    time.Time
+		want time.Time
+	}{
+		{time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC), time.Date(2015, 7, 2, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 2, 12, 0, 0, 0, time.UTC), time.Date(2015, 7, 6, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 4, 8, 30, 0, 0, time.UTC), time.Date(2015, 7, 6, 8, 30, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 23, 12, 0, 0, 0, time.UTC), time.Date(2016, 12, 27, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 30, 12, 0, 0, 0, time.UTC), time.Date(2017, 1, 3, 12, 0, 0, 0, time.UTC)},
+	}
+
+	anchors := make([]time.Time, len(tests))
+	for i, test := range tests {
+		anchors[i] = test.t
+		got := c.NextWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %s; want: %s (%s)", got, test.want, test.t)
+		}
+	}
+
+	got := c.NextWorkdayBatch(anchors)
+	if len(got) != len(tests) {
+		t.Fatalf("got: %d dates; want: %d", len(got), len(tests))
+	}
+	for i, test := range tests {
+		if got[i] != test.want {
+			t.Errorf("got: %s; want: %s (%s)", got[i], test.want, test.t)
+		}
+	}
+}
+
+func TestPreviousWorkday(t *testing.T) {
+	c := NewUSCalendar()
+
+	tests := []struct {
+		t    time.Time
+		want time.Time
+	}{
+		{time.Date(2015, 7, 2, 12, 0, 0, 0, time.UTC), time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 6, 12, 0, 0, 0, time.UTC), time.Date(2015, 7, 2, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 4, 8, 30, 0, 0, time.UTC), time.Date(2015, 7, 2, 8, 30, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 27, 12, 0, 0, 0, time.UTC), time.Date(2016, 12, 23, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2017, 1, 3, 12, 0, 0, 0, time.UTC), time.Date(2016, 12, 30, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got := c.PreviousWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %s; want: %s (%s)", got, test.want, test.t)
+		}
+	}
+
+	c.WorkdayFunc = func(c *Calendar, date time.Time) bool { return false }
+	if got := c.PreviousWorkday(time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC)); !got.IsZero() {
+		t.Errorf("got: %s; want: zero Time", got)
+	}
+}
+
+func TestNearestWorkday(t *testing.T) {
+	c := NewUSCalendar()
+
+	tests := []struct {
+		t      time.Time
+		prefer Direction
+		want   time.Time
+	}{
+		{time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC), Backward, time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 12, 19, 12, 0, 0, 0, time.UTC), Forward, time.Date(2015, 12, 18, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 12, 20, 12, 0, 0, 0, time.UTC), Backward, time.Date(2015, 12, 21, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 4, 8, 30, 0, 0, time.UTC), Forward, time.Date(2015, 7, 6, 8, 30, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 4, 8, 30, 0, 0, time.UTC), Backward, time.Date(2015, 7, 2, 8, 30, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 25, 12, 0, 0, 0, time.UTC), Forward, time.Date(2016, 12, 27, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 25, 12, 0, 0, 0, time.UTC), Backward, time.Date(2016, 12, 23, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 26, 12, 0, 0, 0, time.UTC), Backward, time.Date(2016, 12, 27, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got := c.NearestWorkday(test.t, test.prefer)
+		if got != test.want {
+			t.Errorf("got: %s; want: %s (%s, %d)", got, test.want, test.t, test.prefer)
+		}
+	}
+
+	c.WorkdayFunc = func(c *Calendar, date time.Time) bool { return false }
+	if got := c.NearestWorkday(time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC), Forward); !got.IsZero() {
+		t.Errorf("got: %s; want: zero Time", got)
+	}
+}
+
+func TestWorkdaysFrom(t *testing.T) {
+	c := NewUSCalendar()
+
+	tests := []struct {
+		t    time.Time
+		n    int
+		want time.Time
+	}{
+		{time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC), 0, time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 4, 12, 0, 0, 0, time.UTC), 0, time.Date(2015, 7, 4, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC), 1, time.Date(2015, 7, 2, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 1, 8, 30, 0, 0, time.UTC), 2, time.Date(2015, 7, 6, 8, 30, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC), 10, time.Date(2015, 7, 16, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 6, 12, 0, 0, 0, time.UTC), -1, time.Date(2015, 7, 2, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2015, 7, 4, 12, 0, 0, 0, time.UTC), -2, time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2016, 12, 30, 12, 0, 0, 0, time.UTC), 1, time.Date(2017, 1, 3, 12, 0, 0, 0, time.UTC)},
+		{time.Date(2017, 1, 3, 12, 0, 0, 0, time.UTC), -1, time.Date(2016, 12, 30, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got := c.WorkdaysFrom(test.t, test.n)
+		if got != test.want {
+			t.Errorf("got: %s; want: %s (%s, %d)", got, test.want, test.t, test.n)
+		}
+	}
+
+	c.WorkdayFunc = func(c *Calendar, date time.Time) bool { return false }
+	if got := c.WorkdaysFrom(time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC), 1); !got.IsZero() {
+		t.Errorf("got: %s; want: zero Time", got)
+	}
+}
+
+func benchmarkAnchors() []time.Time {
+	anchors := make([]time.Time, 1000)
+	for i := range anchors {
+		anchors[i] = time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC).AddDate(0, 0, i%365)
+	}
+	return anchors
+}
+
+func BenchmarkNextWorkday(b *testing.B) {
+	c := NewGermanCalendar()
+	anchors := benchmarkAnchors()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, a := range anchors {
+			c.NextWorkday(a)
+		}
+	}
+}
+
+func BenchmarkNextWorkdayBatch(b *testing.B) {
+	c := NewGermanCalendar()
+	anchors := benchmarkAnchors()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		c.NextWorkdayBatch(anchors)
+	}
+}
+
+func TestHalfDayHolidays(t *testing.T) {
+	c := NewUSCalendar()
+	c.AddHoliday(Holiday{Name: "Christmas Eve", Month: time.December, Day: 24, HalfDay: true})
+	c.AddHoliday(Holiday{Name: "New Year's Eve", Month: time.December, Day: 31, HalfDay: true})
+
+	tests := []struct {
+		t    time.Time
+		want float64
+	}{
+		{time.Date(2018, 12, 21, 12, 0, 0, 0, time.UTC), 1},
+		{time.Date(2018, 12, 24, 12, 0, 0, 0, time.UTC), 0.5},
+		{time.Date(2018, 12, 25, 12, 0, 0, 0, time.UTC), 0},
+		{time.Date(2018, 12, 31, 12, 0, 0, 0, time.UTC), 0.5},
+		{time.Date(2016, 12, 23, 12, 0, 0, 0, time.UTC), 1}, // Christmas Eve on Saturday is not moved
+		{time.Date(2016, 12, 24, 12, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, test := range tests {
+		got := c.WorkFraction(test.t)
+		if got != test.want {
+			t.Errorf("got: %g; want: %g (%s)", got, test.want, test.t)
+		}
+	}
+
+	if !c.IsWorkday(time.Date(2018, 12, 24, 12, 0, 0, 0, time.UTC)) || !c.IsHoliday(time.Date(2018, 12, 24, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Christmas Eve to be a holiday on a work day")
+	}
+
+	// December 24th to 31st 2018: three full days and two half days
+	got := c.WorkHours(time.Date(2018, 12, 24, 12, 0, 0, 0, time.UTC), time.Date(2018, 12, 31, 12, 0, 0, 0, time.UTC), 8)
+	if got != 32 {
+		t.Errorf("got: %g; want: 32", got)
+	}
+	if got := c.WorkHours(time.Date(2018, 12, 24, 12, 0, 0, 0, time.UTC), time.Date(2018, 12, 31, 12, 0, 0, 0, time.UTC), 8, RangeHalfOpen); got != 28 {
+		t.Errorf("got: %g; want: 28", got)
+	}
+}
+
+func TestHolidayChanges(t *testing.T) {
+	greatPrayerDay := Holiday{Name: "Store Bededag", Easter: true, Offset: 26, ValidTo: 2023}
+
+	c := NewUSCalendar()
+	c.AddHoliday(US_Juneteenth)
+	c.AddHoliday(greatPrayerDay)
+	c.AddHoliday(LU_EuropeDay)
+	c.AddHoliday(named(NewHolidayExact(2022, time.September, 19), "State Funeral"))
+
+	got := c.HolidayChanges(2020, 2024)
+	want := []HolidayChange{
+		{&greatPrayerDay, 0, 2024},
+		{&US_Juneteenth, 2021, 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got: %d changes; want: %d (%v)", len(got), len(want), got)
+	}
+	for i := range want {
+		if !got[i].Holiday.Equal(*want[i].Holiday) ||
+			got[i].IntroducedYear != want[i].IntroducedYear ||
+			got[i].AbolishedYear != want[i].AbolishedYear {
+			t.Errorf("got: %s %d %d; want: %s %d %d", got[i].Holiday,
+				got[i].IntroducedYear, got[i].AbolishedYear, want[i].Holiday,
+				want[i].IntroducedYear, want[i].AbolishedYear)
+		}
+	}
+
+	if got := c.HolidayChanges(2019, 2019); len(got) != 1 || !got[0].Holiday.Equal(LU_EuropeDay) {
+		t.Errorf("got: %v; want: only %s", got, LU_EuropeDay)
+	}
+	if got := c.HolidayChanges(2022, 2023); len(got) != 0 {
+		t.Errorf("got: %d changes; want: 0", len(got))
+	}
+}
+
+func TestHolidayCount(t *testing.T) {
+	c := NewUSCalendar()
+
+	tests := []struct {
+		y    int
+		want int
+	}{
+		{2017, 10},
+		{2021, 12}, // New Year's Day 2022 is observed on December 31st
+		{2022, 10},
+		{2023, 11},
+	}
+
+	for _, test := range tests {
+		got := c.HolidayCount(test.y)
+		if got != test.want {
+			t.Errorf("got: %d; want: %d (%d)", got, test.want, test.y)
+		}
+	}
+
+	// a second holiday on Martin Luther King Jr. Day
+	c.AddHoliday(Holiday{Name: "Robert E. Lee Day", Month: time.January, Weekday: time.Monday, Offset: 3})
+	if got := c.HolidayCount(2017); got != 10 {
+		t.Errorf("got: %d; want: 10", got)
+	}
+
+	c.Observed = ObservedExact
+	if got := c.HolidayCount(2022); got != 11 {
+		t.Errorf("got: %d; want: 11", got)
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	c := NewUSCalendar()
+
+	got := c.Holidays(2021)
+	if len(got) != 11 {
+		t.Fatalf("got: %d holidays; want: 11", len(got))
+	}
+
+	tests := []struct {
+		i        int
+		name     string
+		date     time.Time
+		observed time.Time
+	}{
+		{0, "New Year's Day", time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{4, "Juneteenth", time.Date(2021, 6, 19, 12, 0, 0, 0, time.UTC), time.Date(2021, 6, 18, 12, 0, 0, 0, time.UTC)},
+		{5, "Independence Day", time.Date(2021, 7, 4, 12, 0, 0, 0, time.UTC), time.Date(2021, 7, 5, 12, 0, 0, 0, time.UTC)},
+		{10, "Christmas Day", time.Date(2021, 12, 25, 12, 0, 0, 0, time.UTC), time.Date(2021, 12, 24, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		h := got[test.i]
+		if h.Name != test.name || !h.Date.Equal(test.date) || !h.Observed.Equal(test.observed) {
+			t.Errorf("got: %s on %s observed %s; want: %s on %s observed %s",
+				h.Name, h.Date, h.Observed, test.name, test.date, test.observed)
+		}
+	}
+
+	// New Year's Day 2022 is observed in the previous year
+	got = c.Holidays(2022)
+	if want := time.Date(2021, 12, 31, 12, 0, 0, 0, time.UTC); !got[0].Observed.Equal(want) {
+		t.Errorf("got: %s; want: %s", got[0].Observed, want)
+	}
+
+	c = NewBritishCalendar()
+	got = c.Holidays(2021)
+	last := got[len(got)-1]
+	if want := time.Date(2021, 12, 28, 12, 0, 0, 0, time.UTC); last.Name != "Boxing Day" || !last.Observed.Equal(want) {
+		t.Errorf("got: %s observed %s; want: Boxing Day observed %s", last.Name, last.Observed, want)
+	}
+}
+
+func TestHolidaysInRange(t *testing.T) {
+	c := NewCalendar()
+	c.Observed = ObservedExact
+	shutdown := NewHolidayRange(time.December, 23, time.December, 31)
+	shutdown.Name = "Shutdown"
+	c.AddHolidays(
+		shutdown,
+		Holiday{Name: "Christmas", Month: time.December, Day: 25},
+		Holiday{Name: "New Year", Month: time.January, Day: 1},
+		Holiday{Name: "Epiphany", Month: time.January, Day: 6},
+	)
+
+	got := c.HolidaysInRange(time.Date(2016, 12, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2017, 1, 31, 12, 0, 0, 0, time.UTC))
+	want := []HolidaySpan{
+		{time.Date(2016, 12, 23, 12, 0, 0, 0, time.UTC), time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC), 10,
+			[]string{"Shutdown", "Christmas", "New Year"}},
+		{time.Date(2017, 1, 6, 12, 0, 0, 0, time.UTC), time.Date(2017, 1, 6, 12, 0, 0, 0, time.UTC), 1,
+			[]string{"Epiphany"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; want: %v", got, want)
+	}
+
+	// spans are cut at the edges of the range
+	got = c.HolidaysInRange(time.Date(2016, 12, 26, 12, 0, 0, 0, time.UTC),
+		time.Date(2016, 12, 31, 12, 0, 0, 0, time.UTC), RangeHalfOpen)
+	if len(got) != 1 || got[0].Days != 5 || !reflect.DeepEqual(got[0].Names, []string{"Shutdown"}) {
+		t.Errorf("got: %v; want: 5 days of Shutdown", got)
+	}
+
+	// moved weekend holidays are listed on the day they are observed
+	c.Observed = ObservedNearest
+	c.AddHoliday(named(NewHolidayExact(2017, time.January, 7), "Saint Distaff"))
+	got = c.HolidaysInRange(time.Date(2017, 1, 3, 12, 0, 0, 0, time.UTC),
+		time.Date(2017, 1, 8, 12, 0, 0, 0, time.UTC))
+	if len(got) != 1 || got[0].Days != 1 || !reflect.DeepEqual(got[0].Names, []string{"Epiphany", "Saint Distaff"}) {
+		t.Errorf("got: %v; want: Epiphany and Saint Distaff", got)
+	}
+
+	c.Observed = ObservedExact
+	if got := c.HolidayCount(2016); got != 11 {
+		t.Errorf("got: %d; want: 11", got)
+	}
+}
+
+func TestHasHolidayInRange(t *testing.T) {
+	c := NewECBCalendar()
+
+	tests := []struct {
+		start, end time.Time
+		want       bool
+	}{
+		{time.Date(2016, 3, 21, 12, 0, 0, 0, time.UTC), time.Date(2016, 3, 27, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2016, 3, 29, 12, 0, 0, 0, time.UTC), time.Date(2016, 4, 29, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2017, 3, 21, 12, 0, 0, 0, time.UTC), time.Date(2017, 3, 27, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2017, 4, 14, 12, 0, 0, 0, time.UTC), time.Date(2017, 4, 14, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2016, 12, 26, 12, 0, 0, 0, time.UTC), time.Date(2016, 12, 24, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2016, 12, 27, 12, 0, 0, 0, time.UTC), time.Date(2016, 12, 31, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.HasHolidayInRange(test.start, test.end)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s - %s)", got, test.want, test.start, test.end)
+		}
+	}
+}
+
+func TestObservedFunc(t *testing.T) {
+	// Sunday moves to Monday, or Tuesday if Monday is a holiday; Saturday stays
+	observed := func(date time.Time, c *Calendar) time.Time {
+		if date.Weekday() != time.Sunday {
+			return date
+		}
+		date = date.AddDate(0, 0, 1)
+		if c.IsHoliday(date) {
+			date = date.AddDate(0, 0, 1)
+		}
+		return date
+	}
+
+	c := NewCalendar()
+	c.Observed = ObservedMonday
+	c.AddHoliday(Holiday{Name: "Christmas", Month: time.December, Day: 25, ObservedFunc: observed})
+	c.AddHoliday(Holiday{Name: "Boxing Day", Month: time.December, Day: 26})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2021, 12, 24, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2021, 12, 27, 12, 0, 0, 0, time.UTC), false}, // Boxing Day by the calendar rule
+		{time.Date(2021, 12, 28, 12, 0, 0, 0, time.UTC), true},  // Christmas stays on Saturday
+		{time.Date(2022, 12, 26, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2022, 12, 27, 12, 0, 0, 0, time.UTC), false}, // Christmas moves past Boxing Day
+		{time.Date(2022, 12, 28, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, 12, 25, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2023, 12, 27, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	spans := c.HolidaysInRange(time.Date(2021, 12, 20, 12, 0, 0, 0, time.UTC), time.Date(2021, 12, 31, 12, 0, 0, 0, time.UTC))
+	if len(spans) != 2 || spans[0].Start.Day() != 25 || spans[0].Names[0] != "Christmas" ||
+		spans[1].Start.Day() != 27 || spans[1].Names[0] != "Boxing Day" {
+		t.Errorf("got: %v; want: Christmas on the 25th and Boxing Day on the 27th", spans)
+	}
+}
+
+func TestObservedSaturdaySunday(t *testing.T) {
+	sunday := NewCalendar()
+	sunday.Observed = ObservedSundayToMonday
+	AddUSHolidays(sunday)
+	saturday := NewCalendar()
+	saturday.Observed = ObservedSaturdayToFriday
+	AddUSHolidays(saturday)
+
+	tests := []struct {
+		c    *Calendar
+		t    time.Time
+		want bool
+	}{
+		{sunday, time.Date(2020, 7, 3, 12, 0, 0, 0, time.UTC), true}, // July 4th on Saturday
+		{sunday, time.Date(2020, 7, 6, 12, 0, 0, 0, time.UTC), true},
+		{sunday, time.Date(2021, 7, 5, 12, 0, 0, 0, time.UTC), false}, // July 4th on Sunday
+		{saturday, time.Date(2020, 7, 3, 12, 0, 0, 0, time.UTC), false},
+		{saturday, time.Date(2021, 7, 2, 12, 0, 0, 0, time.UTC), true},
+		{saturday, time.Date(2021, 7, 5, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		got := test.c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestObservedCascade(t *testing.T) {
+	gb := NewBritishCalendar()
+	us := NewUSCalendar()
+	us.AddHoliday(Holiday{Name: "Christmas Eve", Month: time.December, Day: 24})
+	ecb := NewCalendar()
+	AddHolidaysForCountry(ecb, "ECB")
+
+	tests := []struct {
+		c    *Calendar
+		t    time.Time
+		want bool
+	}{
+		// Christmas on Saturday, Boxing Day on Sunday
+		{gb, time.Date(2021, 12, 27, 12, 0, 0, 0, time.UTC), false},
+		{gb, time.Date(2021, 12, 28, 12, 0, 0, 0, time.UTC), false},
+		{gb, time.Date(2021, 12, 29, 12, 0, 0, 0, time.UTC), true},
+		// Christmas on Sunday, Boxing Day on Monday
+		{gb, time.Date(2022, 12, 26, 12, 0, 0, 0, time.UTC), false},
+		{gb, time.Date(2022, 12, 27, 12, 0, 0, 0, time.UTC), false},
+		{gb, time.Date(2022, 12, 28, 12, 0, 0, 0, time.UTC), true},
+		// Christmas on Saturday shares Friday with Christmas Eve
+		{us, time.Date(2021, 12, 23, 12, 0, 0, 0, time.UTC), true},
+		{us, time.Date(2021, 12, 24, 12, 0, 0, 0, time.UTC), false},
+		// Christmas Eve on Sunday moves past Christmas on Monday
+		{us, time.Date(2023, 12, 25, 12, 0, 0, 0, time.UTC), false},
+		{us, time.Date(2023, 12, 26, 12, 0, 0, 0, time.UTC), false},
+		{us, time.Date(2023, 12, 27, 12, 0, 0, 0, time.UTC), true},
+		// Christmas on Sunday moves past Boxing Day on Monday
+		{ecb, time.Date(2022, 12, 26, 12, 0, 0, 0, time.UTC), false},
+		{ecb, time.Date(2022, 12, 27, 12, 0, 0, 0, time.UTC), false},
+		{ecb, time.Date(2022, 12, 28, 12, 0, 0, 0, time.UTC), true},
+		// a holiday moved back to Friday does not cascade past Christmas
+		{ecb, time.Date(2020, 12, 24, 12, 0, 0, 0, time.UTC), true},
+		{ecb, time.Date(2020, 12, 25, 12, 0, 0, 0, time.UTC), false},
+		{ecb, time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		got := test.c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestHolidayObserved(t *testing.T) {
+	c := NewUSCalendar()
+	c.AddHoliday(NewHolidayObserved(Holiday{Name: "Patriot Day", Month: time.September, Day: 11}, ObservedExact))
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2021, 7, 5, 12, 0, 0, 0, time.UTC), false}, // Independence Day moves to Monday
+		{time.Date(2021, 9, 10, 12, 0, 0, 0, time.UTC), true}, // Patriot Day stays on Saturday
+		{time.Date(2021, 9, 13, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2022, 9, 12, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, 9, 11, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	// the other way around
+	c = NewCalendar()
+	c.Observed = ObservedExact
+	c.AddHoliday(NewHolidayObserved(US_Independence, ObservedNearest))
+	if c.IsWorkday(time.Date(2021, 7, 5, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Independence Day to be observed on Monday")
+	}
+	if !c.IsWorkday(time.Date(2021, 7, 6, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the day after Independence Day to be a workday")
+	}
+}
+
+func TestConcurrentUse(t *testing.T) {
+	c := NewGermanCalendar()
+	AddGermanStateHolidays(c, "BY")
+	start := time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC)
+	want := c.CountWorkdays(start, start.AddDate(4, 0, 0))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := c.CountWorkdays(start, start.AddDate(4, 0, 0)); got != want {
+				t.Errorf("got: %d; want: %d", got, want)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMergeCalendars(t *testing.T) {
+	de := NewGermanCalendar()
+	gb := NewBritishCalendar()
+	c := MergeCalendars(de, gb)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2017, 8, 28, 12, 0, 0, 0, time.UTC), false}, // Summer Bank Holiday
+		{time.Date(2017, 10, 3, 12, 0, 0, 0, time.UTC), false}, // Tag der Deutschen Einheit
+		{time.Date(2017, 10, 4, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 12, 26, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2021, 12, 27, 12, 0, 0, 0, time.UTC), false}, // Christmas Day moved to Monday
+		{time.Date(2021, 12, 28, 12, 0, 0, 0, time.UTC), false}, // Boxing Day moved to Tuesday
+		{time.Date(2021, 12, 29, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 10, 7, 12, 0, 0, 0, time.UTC), false}, // Saturday
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	// the weekend is the union of both weekends
+	il := NewIsraeliCalendar()
+	c = MergeCalendars(il, gb)
+	if !c.IsWeekend(time.Date(2017, 10, 6, 12, 0, 0, 0, time.UTC)) || !c.IsWeekend(time.Date(2017, 10, 8, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Friday to Sunday to be the weekend")
+	}
+	if c.IsWorkday(time.Date(2017, 10, 5, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Sukkot to be a holiday")
+	}
+
+	// each holiday keeps the Easter method of its calendar
+	de.EasterMethod = EasterJulian
+	c = MergeCalendars(de, gb)
+	if c.IsWorkday(time.Date(2016, 3, 25, 12, 0, 0, 0, time.UTC)) || c.IsWorkday(time.Date(2016, 4, 29, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected both Good Fridays to be holidays")
+	}
+	if c.WorkdayFunc != nil || c.EasterMethod != EasterGregorian {
+		t.Errorf("Expected the holidays alone to decide")
+	}
+	if hs := c.HolidaysOn(time.Date(2016, 4, 29, 12, 0, 0, 0, time.UTC)); len(hs) != 1 || !hs[0].Orthodox {
+		t.Errorf("got: %v; want the Orthodox Good Friday", hs)
+	}
+
+	rev := MergeCalendars(gb, de)
+	for date := time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC); date.Year() < 2019; date = date.AddDate(0, 0, 1) {
+		want := de.IsWorkday(date) && gb.IsWorkday(date)
+		if c.IsWorkday(date) != want || rev.IsWorkday(date) != want {
+			t.Errorf("got: %t, %t; want: %t (%s)", c.IsWorkday(date), rev.IsWorkday(date), want, date)
+		}
+	}
+}
+
+func TestCommonWorkdays(t *testing.T) {
+	c := CommonWorkdays(NewUSCalendar(), NewECBCalendar(), NewBritishCalendar())
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2017, 4, 14, 12, 0, 0, 0, time.UTC), false}, // Good Friday
+		{time.Date(2017, 5, 1, 12, 0, 0, 0, time.UTC), false},  // Labour Day
+		{time.Date(2017, 5, 2, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 7, 4, 12, 0, 0, 0, time.UTC), false},   // Independence Day
+		{time.Date(2017, 8, 28, 12, 0, 0, 0, time.UTC), false},  // Summer Bank Holiday
+		{time.Date(2017, 11, 23, 12, 0, 0, 0, time.UTC), false}, // Thanksgiving Day
+		{time.Date(2017, 11, 24, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	us := NewUSCalendar()
+	one := CommonWorkdays(us)
+	if one == us {
+		t.Errorf("Expected a new Calendar")
+	}
+	start := time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC)
+	if got, want := one.CountWorkdays(start, start.AddDate(1, 0, 0)), us.CountWorkdays(start, start.AddDate(1, 0, 0)); got != want {
+		t.Errorf("got: %d; want: %d", got, want)
+	}
+	if got := CommonWorkdays().CountWorkdays(start, start.AddDate(0, 0, 6)); got != 5 {
+		t.Errorf("got: %d; want: 5", got)
+	}
+}
+
+func TestWorkdayOverride(t *testing.T) {
+	c := NewCalendar()
+	c.Observed = ObservedExact
+	for d := 1; d <= 7; d++ {
+		c.AddHoliday(NewHoliday(time.October, d))
+	}
+	c.AddWorkdayOverride(time.Date(2017, 9, 30, 12, 0, 0, 0, time.UTC))
+	c.AddWorkdayOverride(time.Date(2017, 10, 7, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2017, 9, 30, 12, 0, 0, 0, time.UTC), true}, // Saturday
+		{time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2017, 10, 7, 12, 0, 0, 0, time.UTC), false}, // still a holiday
+		{time.Date(2017, 10, 8, 12, 0, 0, 0, time.UTC), false}, // Sunday
+		{time.Date(2017, 10, 14, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2018, 9, 30, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	// the override is kept when merged with a calendar working that day
+	other := NewCalendar()
+	other.SetWorkday(time.Saturday, true)
+	if !MergeCalendars(c, other).IsWorkday(time.Date(2017, 9, 30, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the override to be kept")
+	}
+	if MergeCalendars(c, NewCalendar()).IsWorkday(time.Date(2017, 9, 30, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Did not expect the override to be kept")
+	}
+}
+
+func TestWorkdayFunc(t *testing.T) {
+	c := NewUSCalendar()
+	c.WorkdayFunc = func(c *Calendar, date time.Time) bool {
+		// closed on payday, the last Friday of every month
+		return DefaultWorkday(c, date) && !IsWeekdayN(date, time.Friday, -1)
+	}
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2017, 3, 31, 12, 0, 0, 0, time.UTC), false}, // payday
+		{time.Date(2017, 3, 24, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 7, 4, 12, 0, 0, 0, time.UTC), false}, // holiday
+		{time.Date(2017, 7, 5, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 7, 29, 12, 0, 0, 0, time.UTC), false}, // weekend
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	if got := c.Workdays(2017, time.March); got != 22 {
+		t.Errorf("got: %d; want: 22", got)
+	}
+}
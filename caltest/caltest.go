@@ -0,0 +1,64 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+// Package caltest provides helpers for testing holiday sets built with the
+// cal package.
+package caltest
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+)
+
+// AssertHolidays checks that the holidays of c in the given year are exactly
+// the expected ones. Each expected date must have a holiday of the given
+// name, and no other date may have a holiday. Only the date portion of the
+// expected times is used, and observation on alternate days is not checked.
+func AssertHolidays(t testing.TB, c *cal.Calendar, year int, expected map[time.Time]string) {
+	t.Helper()
+
+	want := make(map[string]string, len(expected))
+	for date, name := range expected {
+		want[date.Format("2006-01-02")] = name
+	}
+
+	var diffs []string
+	date := time.Date(year, time.January, 1, 12, 0, 0, 0, time.UTC)
+	for ; date.Year() == year; date = date.AddDate(0, 0, 1) {
+		key := date.Format("2006-01-02")
+		var got []string
+		for _, h := range c.HolidaysOn(date) {
+			got = append(got, h.Name)
+		}
+		name, ok := want[key]
+		switch {
+		case !ok && len(got) > 0:
+			diffs = append(diffs, "+ "+key+" "+strings.Join(got, ", "))
+		case ok && len(got) == 0:
+			diffs = append(diffs, "- "+key+" "+name)
+		case ok && !contains(got, name):
+			diffs = append(diffs, "- "+key+" "+name, "+ "+key+" "+strings.Join(got, ", "))
+		}
+		delete(want, key)
+	}
+	for key, name := range want {
+		diffs = append(diffs, "- "+key+" "+name+" (not in the year)")
+	}
+
+	if len(diffs) > 0 {
+		sort.SliceStable(diffs, func(i, j int) bool { return diffs[i][2:12] < diffs[j][2:12] })
+		t.Errorf("holidays in %d differ (- expected, + actual):\n%s", year, strings.Join(diffs, "\n"))
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// islamicEpoch is the fixed day number of 1 Muharram AH 1, July 16th 622 in
+// the Julian calendar.
+const islamicEpoch = 227015
+
+// hijriMonth identifies a month of the Islamic calendar.
+type hijriMonth struct {
+	year, month int
+}
+
+// hijriMonthStarts holds the fixed day numbers of the months whose start, as
+// announced by Turkey's Presidency of Religious Affairs, differs from the
+// arithmetical calendar or has been confirmed. Other months follow the
+// arithmetical calendar, which can be a day or two off the announced dates.
+// It is only written by init.
+var hijriMonthStarts = map[hijriMonth]int{}
+
+func init() {
+	for m, d := range map[hijriMonth]time.Time{
+		{1440, 10}: ymd(2019, time.June, 4),
+		{1440, 12}: ymd(2019, time.August, 2),
+		{1441, 10}: ymd(2020, time.May, 24),
+		{1441, 12}: ymd(2020, time.July, 22),
+		{1442, 10}: ymd(2021, time.May, 13),
+		{1442, 12}: ymd(2021, time.July, 11),
+		{1443, 10}: ymd(2022, time.May, 2),
+		{1443, 12}: ymd(2022, time.June, 30),
+		{1444, 10}: ymd(2023, time.April, 21),
+		{1444, 12}: ymd(2023, time.June, 19),
+		{1445, 1}:  ymd(2023, time.July, 19),
+		{1445, 3}:  ymd(2023, time.September, 16),
+		{1445, 10}: ymd(2024, time.April, 10),
+		{1445, 12}: ymd(2024, time.June, 7),
+		{1446, 1}:  ymd(2024, time.July, 7),
+		{1446, 3}:  ymd(2024, time.September, 4),
+		{1446, 10}: ymd(2025, time.March, 30),
+		{1446, 12}: ymd(2025, time.May, 28),
+		{1447, 1}:  ymd(2025, time.June, 26),
+		{1447, 3}:  ymd(2025, time.August, 24),
+		{1447, 10}: ymd(2026, time.March, 20),
+		{1447, 12}: ymd(2026, time.May, 18),
+	} {
+		hijriMonthStarts[m] = fixedDay(d)
+	}
+}
+
+// SetHijriMonthStart records the Gregorian date on which a month of the
+// Islamic calendar starts for the calendar, such as a newly announced
+// beginning of Shawwal (month 10), in place of the known or calculated one.
+func (c *Calendar) SetHijriMonthStart(year, month int, start time.Time) {
+	if c.hijriStarts == nil {
+		c.hijriStarts = make(map[hijriMonth]int)
+	}
+	c.hijriStarts[hijriMonth{year, month}] = fixedDay(start)
+}
+
+// fixedDay converts a date to its fixed day number.
+func fixedDay(date time.Time) int {
+	d := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return unixFixedDay + int(d.Unix()/(24*60*60))
+}
+
+// arithmeticHijri reports the fixed day number of a day of the arithmetical
+// Islamic calendar, which has 30 year cycles of 354 and 355 day years.
+func arithmeticHijri(year, month, day int) int {
+	return day + 29*(month-1) + (6*month-1)/11 + (year-1)*354 + (3+11*year)/30 + islamicEpoch - 1
+}
+
+// hijriStart reports the fixed day number of the first day of a month,
+// looking it up in starts before the known month starts.
+func hijriStart(m hijriMonth, starts map[hijriMonth]int) int {
+	if d, ok := starts[m]; ok {
+		return d
+	}
+	if d, ok := hijriMonthStarts[m]; ok {
+		return d
+	}
+	return arithmeticHijri(m.year, m.month, 1)
+}
+
+// next reports the month after m.
+func (m hijriMonth) next() hijriMonth {
+	if m.month == 12 {
+		return hijriMonth{m.year + 1, 1}
+	}
+	return hijriMonth{m.year, m.month + 1}
+}
+
+// prev reports the month before m.
+func (m hijriMonth) prev() hijriMonth {
+	if m.month == 1 {
+		return hijriMonth{m.year - 1, 12}
+	}
+	return hijriMonth{m.year, m.month - 1}
+}
+
+// hijriDate converts a date to the year, month and day of the Islamic
+// calendar, using starts as hijriStart does.
+func hijriDate(date time.Time, starts map[hijriMonth]int) (year, month, day int) {
+	f := fixedDay(date)
+	year = (30*(f-islamicEpoch) + 10646) / 10631
+	month = (11*(f-arithmeticHijri(year, 1, 1)) + 330) / 325
+	m := hijriMonth{year, month}
+	switch {
+	case f >= hijriStart(m.next(), starts):
+		m = m.next()
+	case f < hijriStart(m, starts):
+		m = m.prev()
+	}
+	return m.year, m.month, f - hijriStart(m, starts) + 1
+}
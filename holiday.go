@@ -3,6 +3,10 @@
 package cal
 
 import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -12,103 +16,217 @@ type ObservedRule int
 
 //ObservedRule are the specific ObservedRules
 const (
-	ObservedNearest ObservedRule = iota // nearest weekday (Friday or Monday)
-	ObservedExact                       // the exact day only
-	ObservedMonday                      // Monday always
+	ObservedNearest          ObservedRule = iota // nearest weekday (Friday or Monday)
+	ObservedExact                                // the exact day only
+	ObservedMonday                               // Monday always
+	ObservedSundayToMonday                       // Monday for Sunday, Saturday stays
+	ObservedSaturdayToFriday                     // Friday for Saturday, Sunday stays
+)
+
+// EasterMethod represents the computus used to calculate the date of Easter.
+type EasterMethod int
+
+// EasterMethod are the specific EasterMethods
+const (
+	EasterGregorian EasterMethod = iota // Western churches
+	EasterJulian                        // Orthodox churches
 )
 
 var (
 	// United States holidays
-	US_NewYear      = NewHoliday(time.January, 1)
-	US_MLK          = NewHolidayFloat(time.January, time.Monday, 3)
-	US_Presidents   = NewHolidayFloat(time.February, time.Monday, 3)
-	US_Memorial     = NewHolidayFloat(time.May, time.Monday, -1)
-	US_Independence = NewHoliday(time.July, 4)
-	US_Labor        = NewHolidayFloat(time.September, time.Monday, 1)
-	US_Columbus     = NewHolidayFloat(time.October, time.Monday, 2)
-	US_Veterans     = NewHoliday(time.November, 11)
-	US_Thanksgiving = NewHolidayFloat(time.November, time.Thursday, 4)
-	US_Christmas    = NewHoliday(time.December, 25)
+	US_NewYear      = NewNamedHoliday("New Year's Day", time.January, 1)
+	US_MLK          = NewNamedHolidayFloat("Martin Luther King Jr. Day", time.January, time.Monday, 3)
+	US_Presidents   = NewNamedHolidayFloat("Presidents' Day", time.February, time.Monday, 3)
+	US_Memorial     = NewNamedHolidayFloat("Memorial Day", time.May, time.Monday, -1)
+	US_Independence = NewNamedHoliday("Independence Day", time.July, 4)
+	US_Labor        = NewNamedHolidayFloat("Labor Day", time.September, time.Monday, 1)
+	US_Columbus     = NewNamedHolidayFloat("Columbus Day", time.October, time.Monday, 2)
+	US_Veterans     = NewNamedHoliday("Veterans Day", time.November, 11)
+	US_Thanksgiving = NewNamedHolidayFloat("Thanksgiving Day", time.November, time.Thursday, 4)
+	US_Christmas    = NewNamedHoliday("Christmas Day", time.December, 25)
+	US_Election     = NewNamedHolidayFunc("Election Day", calculateElection)
+	US_Juneteenth   = Holiday{Name: "Juneteenth", Month: time.June, Day: 19, ValidFrom: 2021}
+
+	// US state holidays
+	US_SewardsDay        = NewNamedHolidayFloat("Seward's Day", time.March, time.Monday, -1)
+	US_AlaskaDay         = NewNamedHoliday("Alaska Day", time.October, 18)
+	US_CesarChavez       = validFrom(NewNamedHoliday("Cesar Chavez Day", time.March, 31), 2001)
+	US_DCEmancipation    = validFrom(NewNamedHoliday("DC Emancipation Day", time.April, 16), 2005)
+	US_PrinceKuhio       = NewNamedHoliday("Prince Jonah Kuhio Kalanianaole Day", time.March, 26)
+	US_Kamehameha        = NewNamedHoliday("King Kamehameha I Day", time.June, 11)
+	US_HawaiiStatehood   = validFrom(NewNamedHolidayFloat("Statehood Day", time.August, time.Friday, 3), 1959)
+	US_MardiGras         = named(NewHolidayEasterOffset(-47), "Mardi Gras")
+	US_PatriotsDay       = NewNamedHolidayFloat("Patriots' Day", time.April, time.Monday, 3)
+	US_TexasIndependence = NewNamedHoliday("Texas Independence Day", time.March, 2)
+	US_SanJacinto        = NewNamedHoliday("San Jacinto Day", time.April, 21)
+	US_PioneerDay        = NewNamedHoliday("Pioneer Day", time.July, 24)
+	US_BenningtonBattle  = NewNamedHoliday("Bennington Battle Day", time.August, 16)
+
+	// US federal and DC observances
+	US_ElectionDay     = periodic(US_Election, 1848, 2)
+	US_InaugurationDay = Holiday{Name: "Inauguration Day", Func: calculateInaugurationDay, ValidFrom: 1937, Interval: 4}
 
 	// Target2 holidays
-	ECB_GoodFriday       = NewHolidayFunc(calculateGoodFriday)
-	ECB_EasterMonday     = NewHolidayFunc(calculateEasterMonday)
-	ECB_NewYearsDay      = NewHoliday(time.January, 1)
-	ECB_LabourDay        = NewHoliday(time.May, 1)
-	ECB_ChristmasDay     = NewHoliday(time.December, 25)
-	ECB_ChristmasHoliday = NewHoliday(time.December, 26)
+	ECB_GoodFriday       = named(NewHolidayEasterOffset(-2), "Good Friday")
+	ECB_EasterMonday     = named(NewHolidayEasterOffset(1), "Easter Monday")
+	ECB_NewYearsDay      = NewNamedHoliday("New Year's Day", time.January, 1)
+	ECB_LabourDay        = NewNamedHoliday("Labour Day", time.May, 1)
+	ECB_ChristmasDay     = NewNamedHoliday("Christmas Day", time.December, 25)
+	ECB_ChristmasHoliday = NewNamedHoliday("Christmas Holiday", time.December, 26)
 
 	// Holidays in Germany
-	DE_Neujahr                = US_NewYear
-	DE_KarFreitag             = NewHolidayFunc(calculateGoodFriday)
-	DE_Ostermontag            = NewHolidayFunc(calculateEasterMonday)
-	DE_TagderArbeit           = NewHoliday(time.May, 1)
-	DE_Himmelfahrt            = NewHolidayFunc(calculateHimmelfahrt)
-	DE_Pfingstmontag          = NewHolidayFunc(calculatePfingstMontag)
-	DE_TagderDeutschenEinheit = NewHoliday(time.October, 3)
-	DE_ErsterWeihnachtstag    = ECB_ChristmasDay
-	DE_ZweiterWeihnachtstag   = ECB_ChristmasHoliday
+	DE_Neujahr                = named(US_NewYear, "Neujahr")
+	DE_KarFreitag             = named(ECB_GoodFriday, "Karfreitag")
+	DE_Ostermontag            = named(ECB_EasterMonday, "Ostermontag")
+	DE_TagderArbeit           = named(ECB_LabourDay, "Tag der Arbeit")
+	DE_Himmelfahrt            = named(catholicAscension, "Christi Himmelfahrt")
+	DE_Pfingstmontag          = named(catholicWhitMonday, "Pfingstmontag")
+	DE_TagderDeutschenEinheit = Holiday{Name: "Tag der Deutschen Einheit", Month: time.October, Day: 3}
+	DE_ErsterWeihnachtstag    = named(ECB_ChristmasDay, "Erster Weihnachtstag")
+	DE_ZweiterWeihnachtstag   = named(ECB_ChristmasHoliday, "Zweiter Weihnachtstag")
+
+	// Holidays in some German states
+	DE_HeiligeDreiKoenige = named(catholicEpiphany, "Heilige Drei Könige")
+	DE_Frauentag          = Holiday{Name: "Internationaler Frauentag", Month: time.March, Day: 8}
+	DE_Fronleichnam       = named(catholicCorpusChristi, "Fronleichnam")
+	DE_MariaeHimmelfahrt  = named(catholicAssumption, "Mariä Himmelfahrt")
+	DE_Weltkindertag      = Holiday{Name: "Weltkindertag", Month: time.September, Day: 20}
+	DE_Reformationstag    = Holiday{Name: "Reformationstag", Month: time.October, Day: 31}
+	DE_Allerheiligen      = named(catholicAllSaints, "Allerheiligen")
+	DE_BussUndBettag      = Holiday{Name: "Buß- und Bettag", Func: calculateBussUndBettag}
 
 	// Holidays in the Netherlands
-	NLNieuwjaar       = US_NewYear
-	NLGoedeVrijdag    = ECB_GoodFriday
-	NLPaasMaandag     = ECB_EasterMonday
-	NLKoningsDag      = NewHolidayFunc(calculateKoningsDag)
-	NLBevrijdingsDag  = NewHoliday(time.May, 5)
-	NLHemelvaart      = DE_Himmelfahrt
-	NLPinksterMaandag = DE_Pfingstmontag
-	NLEersteKerstdag  = ECB_ChristmasDay
-	NLTweedeKerstdag  = ECB_ChristmasHoliday
+	NLNieuwjaar       = named(US_NewYear, "Nieuwjaarsdag")
+	NLGoedeVrijdag    = named(ECB_GoodFriday, "Goede Vrijdag")
+	NLPaasMaandag     = named(ECB_EasterMonday, "Tweede Paasdag")
+	NLKoningsDag      = NewNamedHolidayFunc("Koningsdag", calculateKoningsDag)
+	NLBevrijdingsDag  = NewNamedHoliday("Bevrijdingsdag", time.May, 5)
+	NLHemelvaart      = named(catholicAscension, "Hemelvaartsdag")
+	NLPinksterMaandag = named(catholicWhitMonday, "Tweede Pinksterdag")
+	NLEersteKerstdag  = named(ECB_ChristmasDay, "Eerste Kerstdag")
+	NLTweedeKerstdag  = named(ECB_ChristmasHoliday, "Tweede Kerstdag")
+
+	// Bevrijdingsdag as a day off only in lustrum years, as under many
+	// collective labour agreements (CAOs); not added by AddDutchHolidays
+	NLBevrijdingsDagLustrum = Holiday{Name: "Bevrijdingsdag", Month: time.May, Day: 5, ValidFrom: 1945, Interval: 5}
 
 	// Holidays in Great Britain
-	GB_NewYear       = NewHolidayFunc(calculateNewYearsHoliday)
-	GB_GoodFriday    = ECB_GoodFriday
-	GB_EasterMonday  = ECB_EasterMonday
-	GB_EarlyMay      = NewHolidayFloat(time.May, time.Monday, 1)
-	GB_SpringHoliday = NewHolidayFloat(time.May, time.Monday, -1)
-	GB_SummerHoliday = NewHolidayFloat(time.August, time.Monday, -1)
-	GB_ChristmasDay  = ECB_ChristmasDay
-	GB_BoxingDay     = ECB_ChristmasHoliday
+	GB_NewYear       = NewNamedHolidayFunc("New Year's Day", calculateNewYearsHoliday)
+	GB_GoodFriday    = named(ECB_GoodFriday, "Good Friday")
+	GB_EasterMonday  = named(ECB_EasterMonday, "Easter Monday")
+	GB_EarlyMay      = NewNamedHolidayFloat("Early May Bank Holiday", time.May, time.Monday, 1)
+	GB_SpringHoliday = NewNamedHolidayFloat("Spring Bank Holiday", time.May, time.Monday, -1)
+	GB_SummerHoliday = NewNamedHolidayFloat("Summer Bank Holiday", time.August, time.Monday, -1)
+	GB_ChristmasDay  = named(ECB_ChristmasDay, "Christmas Day")
+	GB_BoxingDay     = named(ECB_ChristmasHoliday, "Boxing Day")
+
+	// Holidays in Scotland and Northern Ireland only
+	GB_SecondJanuary    = NewNamedHolidayFunc("2nd January", calculateSecondJanuary)
+	GB_SummerScotland   = NewNamedHolidayFloat("Summer Bank Holiday", time.August, time.Monday, 1)
+	GB_StAndrewsDay     = NewNamedHoliday("St. Andrew's Day", time.November, 30)
+	GB_StPatricksDay    = NewNamedHoliday("St. Patrick's Day", time.March, 17)
+	GB_BattleOfTheBoyne = NewNamedHoliday("Battle of the Boyne", time.July, 12)
+
+	// Holidays in Canada
+	CA_VictoriaDay = NewNamedHolidayFunc("Victoria Day", calculateVictoriaDay)
+)
+
+// Catholic holidays shared by several regions
+var (
+	catholicEasterMonday  = ECB_EasterMonday
+	catholicAscension     = named(NewHolidayEasterOffset(39), "Ascension Day")
+	catholicWhitMonday    = named(NewHolidayEasterOffset(50), "Whit Monday")
+	catholicCorpusChristi = named(NewHolidayEasterOffset(60), "Corpus Christi")
+	catholicEpiphany      = NewNamedHoliday("Epiphany", time.January, 6)
+	catholicAssumption    = NewNamedHoliday("Assumption Day", time.August, 15)
+	catholicAllSaints     = NewNamedHoliday("All Saints' Day", time.November, 1)
+	catholicImmaculate    = NewNamedHoliday("Immaculate Conception", time.December, 8)
 )
 
 // HolidayFn calculates the occurrence of a holiday for the given year.
 // This is useful for holidays like Easter that depend on complex rules.
 type HolidayFn func(year int, loc *time.Location) (month time.Month, day int)
 
+// HolidayFnOK calculates the occurrence of a holiday for the given year and
+// reports whether the holiday occurs at all in that year. This is useful for
+// holidays that are periodic, abolished or otherwise skip some years.
+type HolidayFnOK func(year int, loc *time.Location) (month time.Month, day int, ok bool)
+
+// ObservedFn reports the day on which a holiday falling on the given date is
+// observed by the calendar. It may check the calendar's holidays, but must not
+// call IsWorkday or other functions that depend on observed holidays.
+type ObservedFn func(date time.Time, c *Calendar) time.Time
+
 // Holiday holds information about the yearly occurrence of a holiday.
 //
 // A valid Holiday consists of one of the following:
 // - Month and Day (such as March 14 for Pi Day)
+// - Month and Day through EndMonth and EndDay (such as a shutdown from
+//   December 24 to January 1)
 // - Month, Weekday, and Offset (such as the second Monday of October for Columbus Day)
 // - Offset (such as the 183rd day of the year for the start of the second half)
-// - Func (to calculate the holiday)
+// - Easter and Offset (such as 1 day after Easter for Easter Monday)
+// - Easter, Orthodox and Offset (such as 2 days before Orthodox Easter for the
+//   Orthodox Good Friday)
+// - Hijri, Month, Day and Offset (such as the 1st of Shawwal for Eid al-Fitr),
+//   where Month and Day are those of the Islamic calendar
+// - Hebrew, Month, Day and Offset (such as the 15th of Nisan for Pesach),
+//   where Month and Day are those of the Hebrew calendar
+// - Base and Offset (such as 1 day after Thanksgiving for Black Friday)
+// - Func or FuncOK (to calculate the holiday)
+//
+// A Month, Weekday, and Offset holiday does not occur in a month with fewer
+// than Offset of the weekday, unless Clamp is set, in which case it falls on
+// the last occurrence (or for a negative Offset, the first occurrence).
+//
+// ValidFrom and ValidTo optionally limit the holiday to a range of years
+// (inclusive); a zero value leaves that end of the range open. Interval
+// optionally limits the holiday to every Interval years counting from
+// ValidFrom (or from year 0 if ValidFrom is not set). OnlyWeekdays optionally
+// limits the holiday to years in which it falls on one of the given days of
+// the week. Name optionally identifies the holiday.
+//
+// Observed, if set, is the ObservedRule of the holiday in place of the
+// calendar's. ObservedFunc, if set, determines the day the holiday is observed
+// on in place of either rule.
+//
+// A HalfDay holiday, such as an afternoon closure on Christmas Eve, leaves the
+// day a work day with half of the working time. It is observed on the exact
+// day unless Observed or ObservedFunc say otherwise.
 type Holiday struct {
-	Month   time.Month
-	Weekday time.Weekday
-	Day     int
-	Offset  int
-	Func    HolidayFn
-
-	// last values used to calculate month and day with Func
-	lastYear int
-	lastLoc  *time.Location
-}
+	Name      string
+	Month     time.Month
+	Weekday   time.Weekday
+	Day       int
+	EndMonth  time.Month
+	EndDay    int
+	Offset    int
+	Clamp     bool
+	Easter    bool
+	Orthodox  bool
+	Hijri     bool
+	Hebrew    bool
+	Base      *Holiday
+	Func      HolidayFn
+	FuncOK    HolidayFnOK
+	ValidFrom int
+	ValidTo   int
+	Interval  int
 
-func calculateGoodFriday(year int, loc *time.Location) (time.Month, int) {
-	easter := calculateEaster(year, loc)
-	//Go the the day before yesterday
-	gf := easter.AddDate(0, 0, -2)
-	return gf.Month(), gf.Day()
+	OnlyWeekdays []time.Weekday
+	HalfDay      bool
+	Observed     *ObservedRule
+	ObservedFunc ObservedFn
 }
 
-func calculateEasterMonday(year int, loc *time.Location) (time.Month, int) {
-	easter := calculateEaster(year, loc)
-	//Go the the day after Easter
-	em := easter.AddDate(0, 0, +1)
-	return em.Month(), em.Day()
+func calculateEaster(year int, loc *time.Location) time.Time {
+	month, day := easterMonthDay(year)
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
 }
 
-func calculateEaster(year int, loc *time.Location) time.Time {
+// easterMonthDay calculates the month and day of Easter Sunday in the
+// Gregorian calendar.
+func easterMonthDay(year int) (time.Month, int) {
 	// Meeus/Jones/Butcher algorithm
 	y := year
 	a := y % 19
@@ -127,21 +245,69 @@ func calculateEaster(year int, loc *time.Location) time.Time {
 	month := (h + l - 7*m + 114) / 31
 	day := ((h + l - 7*m + 114) % 31) + 1
 
-	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+	return time.Month(month), day
 }
 
-func calculateHimmelfahrt(year int, loc *time.Location) (time.Month, int) {
-	easter := calculateEaster(year, loc)
-	//Go the the day after Easter
-	em := easter.AddDate(0, 0, +39)
-	return em.Month(), em.Day()
+func calculateOrthodoxEaster(year int, loc *time.Location) time.Time {
+	month, day := orthodoxEasterMonthDay(year)
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
 }
 
-func calculatePfingstMontag(year int, loc *time.Location) (time.Month, int) {
-	easter := calculateEaster(year, loc)
-	//Go the the day after Easter
-	em := easter.AddDate(0, 0, +50)
-	return em.Month(), em.Day()
+// orthodoxEasterMonthDay calculates the month and day of Easter Sunday in the
+// Julian calendar, converted to the Gregorian calendar. The day may be past
+// the end of the month.
+func orthodoxEasterMonthDay(year int) (time.Month, int) {
+	// Meeus Julian algorithm
+	a := year % 4
+	b := year % 7
+	c := year % 19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+
+	month := (d + e + 114) / 31
+	day := ((d + e + 114) % 31) + 1
+
+	// convert from the Julian to the Gregorian calendar
+	day += year/100 - year/400 - 2
+
+	return time.Month(month), day
+}
+
+// easter calculates the date of Easter Sunday using the method.
+func (m EasterMethod) easter(year int, loc *time.Location) time.Time {
+	if m == EasterJulian {
+		return calculateOrthodoxEaster(year, loc)
+	}
+	return calculateEaster(year, loc)
+}
+
+// easterYearDay calculates the day of the year of Easter Sunday using the
+// method.
+func (m EasterMethod) easterYearDay(year int) int {
+	month, day := easterMonthDay(year)
+	if m == EasterJulian {
+		month, day = orthodoxEasterMonthDay(year)
+	}
+	return yearDay(year, month, day)
+}
+
+// daysBefore holds the number of days in a non-leap year before each month.
+var daysBefore = [...]int{0, 0, 31, 59, 90, 120, 151, 181, 212, 243, 273, 304, 334}
+
+// yearDay reports the day of the year of a month and day, like
+// time.Time.YearDay. The day may be past the end of the month.
+func yearDay(year int, month time.Month, day int) int {
+	n := daysBefore[month] + day
+	if month > time.February && (year%4 == 0 && (year%100 != 0 || year%400 == 0)) {
+		n++
+	}
+	return n
+}
+
+// Buß- und Bettag is the Wednesday before November 23rd.
+func calculateBussUndBettag(year int, loc *time.Location) (time.Month, int) {
+	day := WeekdayBefore(time.Date(year, time.November, 23, 0, 0, 0, 0, loc), time.Wednesday)
+	return day.Month(), day.Day()
 }
 
 //KoningsDag (kingsday) is April 27th, 26th if the 27th is a Sunday
@@ -165,31 +331,401 @@ func calculateNewYearsHoliday(year int, loc *time.Location) (time.Month, int) {
 	return time.January, day.Day()
 }
 
+// The 2nd of January is kept in Scotland on the first weekday after it that
+// is not taken by New Year's Day.
+func calculateSecondJanuary(year int, loc *time.Location) (time.Month, int) {
+	switch time.Date(year, time.January, 1, 0, 0, 0, 0, loc).Weekday() {
+	case time.Friday, time.Saturday:
+		return time.January, 4
+	case time.Sunday:
+		return time.January, 3
+	}
+	return time.January, 2
+}
+
+// Election Day is the Tuesday after the first Monday of November, so it never
+// falls on November 1st.
+func calculateElection(year int, loc *time.Location) (time.Month, int) {
+	day := WeekdayOnOrAfter(time.Date(year, time.November, 2, 0, 0, 0, 0, loc), time.Tuesday)
+	return day.Month(), day.Day()
+}
+
+// Inauguration Day is January 20th, or January 21st when the 20th is a
+// Sunday.
+func calculateInaugurationDay(year int, loc *time.Location) (time.Month, int) {
+	day := time.Date(year, time.January, 20, 0, 0, 0, 0, loc)
+	if day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day.Month(), day.Day()
+}
+
+// Victoria Day is the last Monday preceding May 25th.
+func calculateVictoriaDay(year int, loc *time.Location) (time.Month, int) {
+	day := WeekdayOnOrBefore(time.Date(year, time.May, 24, 0, 0, 0, 0, loc), time.Monday)
+	return day.Month(), day.Day()
+}
+
 // NewHoliday creates a new Holiday instance for an exact day of a month.
 func NewHoliday(month time.Month, day int) Holiday {
 	return Holiday{Month: month, Day: day}
 }
 
+// NewHolidayExact creates a new Holiday instance for a day that only occurs
+// in one year, such as a state funeral.
+func NewHolidayExact(year int, month time.Month, day int) Holiday {
+	return Holiday{Month: month, Day: day, ValidFrom: year, ValidTo: year}
+}
+
+// NewNamedHoliday creates a new Holiday instance with a name for an exact day
+// of a month.
+func NewNamedHoliday(name string, month time.Month, day int) Holiday {
+	return Holiday{Name: name, Month: month, Day: day}
+}
+
+// NewHolidayRange creates a new Holiday instance for every day from one day
+// of a month through another, inclusive. The range continues into the next
+// year if the end is before the start.
+func NewHolidayRange(month time.Month, day int, endMonth time.Month, endDay int) Holiday {
+	return Holiday{Month: month, Day: day, EndMonth: endMonth, EndDay: endDay}
+}
+
+// NewHolidayWeekdayInRange creates a new Holiday instance for the given
+// weekday that falls from one day of a month through another, inclusive. Over
+// a range of seven days it occurs once a year, such as Midsummer's Day on the
+// Saturday from June 20th through June 26th.
+func NewHolidayWeekdayInRange(weekday time.Weekday, month time.Month, day int, endMonth time.Month, endDay int) Holiday {
+	h := NewHolidayRange(month, day, endMonth, endDay)
+	h.OnlyWeekdays = []time.Weekday{weekday}
+	return h
+}
+
+// NewHolidayEveryDay creates a new Holiday instance that occurs on every day
+// of the year.
+func NewHolidayEveryDay() Holiday {
+	return NewHolidayRange(time.January, 1, time.December, 31)
+}
+
+// NewHolidayNever creates a new Holiday instance that never occurs.
+func NewHolidayNever() Holiday {
+	return Holiday{}
+}
+
+// NewHolidayEasterOffset creates a new Holiday instance for a number of days
+// before (negative) or after (positive) Easter Sunday, as calculated by the
+// EasterMethod of the calendar.
+func NewHolidayEasterOffset(days int) Holiday {
+	return Holiday{Easter: true, Offset: days}
+}
+
+// NewHolidayOrthodoxEasterOffset creates a new Holiday instance for a number
+// of days before (negative) or after (positive) Orthodox Easter Sunday,
+// whatever the EasterMethod of the calendar.
+func NewHolidayOrthodoxEasterOffset(days int) Holiday {
+	return Holiday{Easter: true, Orthodox: true, Offset: days}
+}
+
+// NewHolidayHijri creates a new Holiday instance for a day of a month of the
+// Islamic calendar, such as the 10th of Dhu al-Hijjah (month 12) for Eid
+// al-Adha. It can occur twice in a single year.
+func NewHolidayHijri(month, day int) Holiday {
+	return Holiday{Hijri: true, Month: time.Month(month), Day: day}
+}
+
+// NewHolidayHebrew creates a new Holiday instance for a day of a month of the
+// Hebrew calendar. Months are numbered from Nisan (1) to Adar (12), which is
+// Adar I in a leap year, followed by Adar II (13) in a leap year only; Tishri,
+// the month of Rosh Hashanah, is month 7.
+func NewHolidayHebrew(month, day int) Holiday {
+	return Holiday{Hebrew: true, Month: time.Month(month), Day: day}
+}
+
 // NewHolidayFloat creates a new Holiday instance for an offset-based day of
 // a month.
 func NewHolidayFloat(month time.Month, weekday time.Weekday, offset int) Holiday {
 	return Holiday{Month: month, Weekday: weekday, Offset: offset}
 }
 
+// NewNamedHolidayFloat creates a new Holiday instance with a name for an
+// offset-based day of a month.
+func NewNamedHolidayFloat(name string, month time.Month, weekday time.Weekday, offset int) Holiday {
+	return Holiday{Name: name, Month: month, Weekday: weekday, Offset: offset}
+}
+
 // NewHolidayFunc creates a new Holiday instance that uses a function to
 // calculate the day and month.
 func NewHolidayFunc(fn HolidayFn) Holiday {
 	return Holiday{Func: fn}
 }
 
+// NewNamedHolidayFunc creates a new Holiday instance with a name that uses a
+// function to calculate the day and month.
+func NewNamedHolidayFunc(name string, fn HolidayFn) Holiday {
+	return Holiday{Name: name, Func: fn}
+}
+
+// NewHolidayRelative creates a new Holiday instance for a number of days
+// before (negative offset) or after another holiday.
+func NewHolidayRelative(base Holiday, offset int) Holiday {
+	return Holiday{Base: &base, Offset: offset}
+}
+
+// NewHolidayObserved returns a copy of the holiday that is observed according
+// to the rule, whatever the ObservedRule of the calendar.
+func NewHolidayObserved(h Holiday, rule ObservedRule) Holiday {
+	h.Observed = &rule
+	return h
+}
+
+// NewHolidayFuncOK creates a new Holiday instance that uses a function to
+// calculate the day and month, or to report that the holiday does not occur
+// in a year.
+func NewHolidayFuncOK(fn HolidayFnOK) Holiday {
+	return Holiday{FuncOK: fn}
+}
+
+// String describes the holiday by its Name, if any, and the rule used to
+// find its date.
+func (h Holiday) String() string {
+	var rule string
+	switch {
+	case h.Easter && h.Orthodox:
+		rule = fmt.Sprintf("%+d days from Orthodox Easter", h.Offset)
+	case h.Easter:
+		rule = fmt.Sprintf("%+d days from Easter", h.Offset)
+	case h.Hijri && h.Offset != 0:
+		rule = fmt.Sprintf("%+d days from day %d of Hijri month %d", h.Offset, h.Day, h.Month)
+	case h.Hijri:
+		rule = fmt.Sprintf("day %d of Hijri month %d", h.Day, h.Month)
+	case h.Hebrew && h.Offset != 0:
+		rule = fmt.Sprintf("%+d days from day %d of Hebrew month %d", h.Offset, h.Day, h.Month)
+	case h.Hebrew:
+		rule = fmt.Sprintf("day %d of Hebrew month %d", h.Day, h.Month)
+	case h.Base != nil:
+		rule = fmt.Sprintf("%+d days from %s", h.Offset, h.Base)
+	case h.Func != nil || h.FuncOK != nil:
+		rule = "calculated"
+	case h.EndMonth > 0:
+		rule = fmt.Sprintf("%s %d to %s %d", h.Month, h.Day, h.EndMonth, h.EndDay)
+	case h.Month > 0 && h.Day > 0:
+		rule = fmt.Sprintf("%s %d", h.Month, h.Day)
+	case h.Month > 0:
+		rule = fmt.Sprintf("%s %s of %s", ordinal(h.Offset), h.Weekday, h.Month)
+	default:
+		rule = fmt.Sprintf("day %d of the year", h.Offset)
+	}
+	if len(h.OnlyWeekdays) > 0 {
+		days := make([]string, len(h.OnlyWeekdays))
+		for i, d := range h.OnlyWeekdays {
+			days[i] = d.String()
+		}
+		rule += " on " + strings.Join(days, ", ")
+	}
+	if h.HalfDay {
+		rule += ", half day"
+	}
+	if h.Name == "" {
+		return rule
+	}
+	return fmt.Sprintf("%s (%s)", h.Name, rule)
+}
+
+// ordinal formats n as an English ordinal; negative values count from the
+// end.
+func ordinal(n int) string {
+	if n == -1 {
+		return "last"
+	}
+	if n < 0 {
+		return ordinal(-n) + " last"
+	}
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// displayName reports the Name of the holiday, or its rule if it has none.
+func (h Holiday) displayName() string {
+	if h.Name != "" {
+		return h.Name
+	}
+	return h.String()
+}
+
+// Equal reports whether h and o describe the same holiday. Functions are
+// compared by identity, so two closures created from the same function
+// literal are considered equal.
+func (h Holiday) Equal(o Holiday) bool {
+	if h.Name != o.Name || h.Weekday != o.Weekday || h.Offset != o.Offset ||
+		h.EndMonth != o.EndMonth || h.EndDay != o.EndDay ||
+		h.Clamp != o.Clamp || h.Easter != o.Easter || h.Orthodox != o.Orthodox || h.Hijri != o.Hijri ||
+		h.Hebrew != o.Hebrew ||
+		h.HalfDay != o.HalfDay ||
+		h.ValidFrom != o.ValidFrom || h.ValidTo != o.ValidTo || h.Interval != o.Interval ||
+		funcPointer(h.Func) != funcPointer(o.Func) ||
+		funcPointer(h.FuncOK) != funcPointer(o.FuncOK) ||
+		funcPointer(h.ObservedFunc) != funcPointer(o.ObservedFunc) ||
+		(h.Observed == nil) != (o.Observed == nil) ||
+		(h.Observed != nil && *h.Observed != *o.Observed) ||
+		!sameWeekdays(h.OnlyWeekdays, o.OnlyWeekdays) {
+		return false
+	}
+	if (h.Base == nil) != (o.Base == nil) ||
+		(h.Base != nil && !h.Base.Equal(*o.Base)) {
+		return false
+	}
+	if h.Func != nil || h.FuncOK != nil {
+		// Month and Day are not used by calculated holidays
+		return true
+	}
+	return h.Month == o.Month && h.Day == o.Day
+}
+
+// sameWeekdays reports whether a and b hold the same days in the same order.
+func sameWeekdays(a, b []time.Weekday) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// funcPointer reports the code pointer of a function, or 0 for nil.
+func funcPointer(fn interface{}) uintptr {
+	v := reflect.ValueOf(fn)
+	if v.IsNil() {
+		return 0
+	}
+	return v.Pointer()
+}
+
+// Next reports the first date on or after from on which the holiday occurs.
+// Dates are compared in loc (or in the location of from if loc is nil) and the
+// result is midnight in loc, so a holiday on the same day as from is reported
+// even though that midnight is before from. Easter based
+// holidays use EasterGregorian and no observance rule is applied. The zero
+// Time is reported if the holiday does not occur within ten years of from or
+// its ValidFrom year.
+func (h Holiday) Next(from time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = from.Location()
+	}
+	from = from.In(loc)
+	if h.ValidTo > 0 && from.Year() > h.ValidTo {
+		return time.Time{}
+	}
+	date := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	if h.ValidFrom > date.Year() {
+		date = time.Date(h.ValidFrom, time.January, 1, 0, 0, 0, 0, loc)
+	}
+	for end := date.AddDate(10, 0, 0); date.Before(end); date = date.AddDate(0, 0, 1) {
+		if h.matches(date, nil) {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
+// calc calculates the month and day of a Func or FuncOK holiday for the given
+// year. A HolidayFn always occurs.
+func (h *Holiday) calc(year int, loc *time.Location) (time.Month, int, bool) {
+	if h.FuncOK != nil {
+		return h.FuncOK(year, loc)
+	}
+	month, day := h.Func(year, loc)
+	return month, day, true
+}
+
+// ownObserved reports whether the holiday has its own observance instead of
+// following the calendar's ObservedRule.
+func (h *Holiday) ownObserved() bool {
+	return h.Observed != nil || h.ObservedFunc != nil || h.HalfDay
+}
+
+// index reports the calendar list the holiday belongs to: 0 for holidays that
+// are not limited to a single known month, otherwise the month.
+func (h *Holiday) index() time.Month {
+	if h.EndMonth > 0 || h.Easter || h.Hijri || h.Hebrew || h.Base != nil || h.Func != nil || h.FuncOK != nil {
+		return 0
+	}
+	return h.Month
+}
+
+// inRange reports whether the date falls within a range holiday.
+func (h *Holiday) inRange(date time.Time) bool {
+	md := int(date.Month())*100 + date.Day()
+	start := int(h.Month)*100 + h.Day
+	end := int(h.EndMonth)*100 + h.EndDay
+	if end < start {
+		return md >= start || md <= end
+	}
+	return md >= start && md <= end
+}
+
 // matches determines whether the given date is the one referred to by the
-// Holiday.
-func (h *Holiday) matches(date time.Time) bool {
+// Holiday. Easter based holidays are calculated with the EasterMethod of the
+// calendar and Hijri based ones with its month starts; a nil calendar uses
+// EasterGregorian and the known month starts. It does not modify the Holiday,
+// so it is safe to call concurrently.
+func (h *Holiday) matches(date time.Time, c *Calendar) bool {
+	if (h.ValidFrom > 0 && date.Year() < h.ValidFrom) ||
+		(h.ValidTo > 0 && date.Year() > h.ValidTo) ||
+		(h.Interval > 1 && (date.Year()-h.ValidFrom)%h.Interval != 0) {
+		return false
+	}
+	if len(h.OnlyWeekdays) > 0 && !hasWeekday(h.OnlyWeekdays, date.Weekday()) {
+		return false
+	}
+
+	if h.Func != nil || h.FuncOK != nil {
+		month, day, ok := h.calc(date.Year(), date.Location())
+		return ok && date.Month() == month && date.Day() == day
+	}
 
-	if h.Func != nil && (date.Year() != h.lastYear || date.Location() != h.lastLoc) {
-		h.Month, h.Day = h.Func(date.Year(), date.Location())
-		h.lastYear = date.Year()
-		h.lastLoc = date.Location()
+	if h.Base != nil {
+		// the base may fall in another year, e.g. New Year's Eve
+		return h.Base.matches(date.AddDate(0, 0, -h.Offset), c)
+	}
+
+	if h.Easter {
+		method := EasterGregorian
+		if c != nil {
+			method = c.EasterMethod
+		}
+		if h.Orthodox {
+			method = EasterJulian
+		}
+		return date.YearDay() == method.easterYearDay(date.Year())+h.Offset
+	}
+
+	if h.Hijri {
+		var starts map[hijriMonth]int
+		if c != nil {
+			starts = c.hijriStarts
+		}
+		_, month, day := hijriDate(date.AddDate(0, 0, -h.Offset), starts)
+		return month == int(h.Month) && day == h.Day
+	}
+
+	if h.Hebrew {
+		_, month, day := hebrewDate(date.AddDate(0, 0, -h.Offset))
+		return month == int(h.Month) && day == h.Day
+	}
+
+	if h.EndMonth > 0 {
+		return h.inRange(date)
 	}
 
 	if h.Month > 0 {
@@ -199,8 +735,9 @@ func (h *Holiday) matches(date time.Time) bool {
 		if h.Day > 0 {
 			return date.Day() == h.Day
 		}
-		if h.Weekday > 0 && h.Offset != 0 {
-			return IsWeekdayN(date, h.Weekday, h.Offset)
+		if h.Offset != 0 {
+			return IsWeekdayN(date, h.Weekday, h.Offset) ||
+				(h.Clamp && isClampedWeekdayN(date, h.Weekday, h.Offset))
 		}
 	} else if h.Offset > 0 {
 		return date.YearDay() == h.Offset
@@ -208,40 +745,433 @@ func (h *Holiday) matches(date time.Time) bool {
 	return false
 }
 
+// hasWeekday reports whether day is one of days.
+func hasWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// isClampedWeekdayN reports whether the given date is the last (n > 0) or
+// first (n < 0) occurrence of the day in a month that has fewer than n of
+// them.
+func isClampedWeekdayN(date time.Time, day time.Weekday, n int) bool {
+	if n > 0 {
+		return IsWeekdayN(date, day, -1) && (date.Day()-1)/7+1 < n
+	}
+	return IsWeekdayN(date, day, 1) && (MonthEnd(date).Day()-date.Day())/7+1 < -n
+}
+
+// Builtin holiday sets
+var (
+	usHolidays = []Holiday{
+		US_NewYear,
+		US_MLK,
+		US_Presidents,
+		US_Memorial,
+		US_Juneteenth,
+		US_Independence,
+		US_Labor,
+		US_Columbus,
+		US_Veterans,
+		US_Thanksgiving,
+		US_Christmas,
+	}
+
+	// The New York Stock Exchange keeps Good Friday but not Columbus Day or
+	// Veterans Day, and does not close on the Friday before a New Year's Day
+	// that falls on a Saturday.
+	usMarketHolidays = []Holiday{
+		NewHolidayObserved(US_NewYear, ObservedSundayToMonday),
+		US_MLK,
+		US_Presidents,
+		ECB_GoodFriday,
+		US_Memorial,
+		validFrom(US_Juneteenth, 2022),
+		US_Independence,
+		US_Labor,
+		US_Thanksgiving,
+		US_Christmas,
+	}
+
+	ecbHolidays = []Holiday{
+		ECB_NewYearsDay,
+		ECB_GoodFriday,
+		ECB_EasterMonday,
+		ECB_LabourDay,
+		ECB_ChristmasDay,
+		ECB_ChristmasHoliday,
+	}
+
+	germanHolidays = []Holiday{
+		DE_Neujahr,
+		DE_KarFreitag,
+		DE_Ostermontag,
+		DE_TagderArbeit,
+		DE_Himmelfahrt,
+		DE_Pfingstmontag,
+		DE_TagderDeutschenEinheit,
+		DE_ErsterWeihnachtstag,
+		DE_ZweiterWeihnachtstag,
+	}
+
+	dutchHolidays = []Holiday{
+		NLNieuwjaar,
+		NLGoedeVrijdag,
+		NLPaasMaandag,
+		NLKoningsDag,
+		NLBevrijdingsDag,
+		NLHemelvaart,
+		NLPinksterMaandag,
+		NLEersteKerstdag,
+		NLTweedeKerstdag,
+	}
+
+	britishHolidays = []Holiday{
+		GB_NewYear,
+		GB_GoodFriday,
+		GB_EasterMonday,
+		GB_EarlyMay,
+		GB_SpringHoliday,
+		GB_SummerHoliday,
+		GB_ChristmasDay,
+		GB_BoxingDay,
+	}
+)
+
+// regions maps each region code to its builtin holiday set.
+var regions = map[string][]Holiday{
+	"US":  usHolidays,
+	"ECB": ecbHolidays,
+	"DE":  germanHolidays,
+	"NL":  dutchHolidays,
+	"GB":  britishHolidays,
+	"LU":  luxembourgHolidays,
+	"IL":  israeliHolidays,
+	"FR":  frenchHolidays,
+	"IT":  italianHolidays,
+	"ES":  spanishHolidays,
+	"CA":  canadianHolidays,
+	"AU":  australianHolidays,
+	"NZ":  newZealandHolidays,
+	"IE":  irishHolidays,
+	"AT":  austrianHolidays,
+	"CH":  swissHolidays,
+	"BE":  belgianHolidays,
+	"PL":  polishHolidays,
+	"SE":  swedishHolidays,
+	"NO":  norwegianHolidays,
+	"DK":  danishHolidays,
+	"FI":  finnishHolidays,
+	"PT":  portugueseHolidays,
+	"CZ":  czechHolidays,
+	"SK":  slovakHolidays,
+	"RU":  russianHolidays,
+	"TR":  turkishHolidays,
+	"JP":  japaneseHolidays,
+	"CN":  chineseHolidays,
+	"KR":  southKoreanHolidays,
+	"IN":  indianHolidays,
+	"BR":  brazilianHolidays,
+	"MX":  mexicanHolidays,
+	"AR":  argentineHolidays,
+	"ZA":  southAfricanHolidays,
+	"SG":  singaporeanHolidays,
+	"HK":  hongKongHolidays,
+	"GR":  greekHolidays,
+	"UA":  ukrainianHolidays,
+	"RO":  romanianHolidays,
+	"BG":  bulgarianHolidays,
+	"AE":  emiratiHolidays,
+	"SA":  saudiHolidays,
+}
+
+// Regions reports the codes of all regions with a builtin holiday set, in
+// sorted order.
+func Regions() []string {
+	codes := make([]string, 0, len(regions))
+	for code := range regions {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// HolidaysForRegion reports the builtin holidays of the region with the given
+// code, or nil if there is no such region.
+func HolidaysForRegion(code string) []Holiday {
+	hs, ok := regions[code]
+	if !ok {
+		return nil
+	}
+	return append([]Holiday(nil), hs...)
+}
+
+// AddHolidaysForCountry adds the builtin holidays of the region with the
+// given code to Calendar.
+func AddHolidaysForCountry(c *Calendar, code string) error {
+	hs, ok := regions[code]
+	if !ok {
+		return fmt.Errorf("cal: unknown region %q", code)
+	}
+	c.AddHolidays(hs...)
+	return nil
+}
+
+// AddUSHolidays adds all US federal holidays to Calendar
+func AddUSHolidays(c *Calendar) {
+	c.AddHolidays(usHolidays...)
+}
+
+// AddUSFederalHolidays adds all US federal holidays to Calendar. It is the
+// same as AddUSHolidays.
+func AddUSFederalHolidays(c *Calendar) {
+	AddUSHolidays(c)
+}
+
+// AddUSMarketHolidays adds all holidays of the New York Stock Exchange to
+// Calendar
+func AddUSMarketHolidays(c *Calendar) {
+	c.AddHolidays(usMarketHolidays...)
+}
+
+// AddUSFederalObservances adds Election Day and Inauguration Day to Calendar.
+// It takes the place of US_Election, which also occurs in odd years; adding
+// both puts Election Day on a date twice.
+func AddUSFederalObservances(c *Calendar) {
+	c.AddHolidays(US_ElectionDay, US_InaugurationDay)
+}
+
+// AddECBHolidays adds all Target2 closing days to Calendar
+func AddECBHolidays(c *Calendar) {
+	c.AddHolidays(ecbHolidays...)
+}
+
 //AddGermanHolidays adds all German Holdays to Calendar
 func AddGermanHolidays(c *Calendar) {
-	c.AddHoliday(DE_Neujahr)
-	c.AddHoliday(DE_KarFreitag)
-	c.AddHoliday(DE_Ostermontag)
-	c.AddHoliday(DE_TagderArbeit)
-	c.AddHoliday(DE_Himmelfahrt)
-	c.AddHoliday(DE_Pfingstmontag)
-	c.AddHoliday(DE_TagderDeutschenEinheit)
-	c.AddHoliday(DE_ErsterWeihnachtstag)
-	c.AddHoliday(DE_ZweiterWeihnachtstag)
+	c.AddHolidays(germanHolidays...)
+}
+
+// germanStateHolidays holds the holidays of each German state in addition to
+// the nationwide ones, by state code.
+var germanStateHolidays = map[string][]Holiday{
+	"BW": {DE_HeiligeDreiKoenige, DE_Fronleichnam, DE_Allerheiligen},
+	"BY": {DE_HeiligeDreiKoenige, DE_Fronleichnam, DE_MariaeHimmelfahrt, DE_Allerheiligen},
+	"BE": {validFrom(DE_Frauentag, 2019)},
+	"BB": {DE_Reformationstag},
+	"HB": {validFrom(DE_Reformationstag, 2018)},
+	"HH": {validFrom(DE_Reformationstag, 2018)},
+	"HE": {DE_Fronleichnam},
+	"MV": {validFrom(DE_Frauentag, 2023), DE_Reformationstag},
+	"NI": {validFrom(DE_Reformationstag, 2018)},
+	"NW": {DE_Fronleichnam, DE_Allerheiligen},
+	"RP": {DE_Fronleichnam, DE_Allerheiligen},
+	"SL": {DE_Fronleichnam, DE_MariaeHimmelfahrt, DE_Allerheiligen},
+	"SN": {DE_Reformationstag, DE_BussUndBettag},
+	"ST": {DE_HeiligeDreiKoenige, DE_Reformationstag},
+	"SH": {validFrom(DE_Reformationstag, 2018)},
+	"TH": {validFrom(DE_Weltkindertag, 2019), DE_Reformationstag},
+}
+
+// named returns a copy of the holiday with the given name.
+func named(h Holiday, name string) Holiday {
+	h.Name = name
+	return h
+}
+
+// observedExact returns a copy of the holiday that is observed on its own
+// day, even on a weekend.
+func observedExact(h Holiday) Holiday {
+	return NewHolidayObserved(h, ObservedExact)
+}
+
+// validFrom returns a copy of the holiday that only occurs from the given
+// year.
+func validFrom(h Holiday, year int) Holiday {
+	h.ValidFrom = year
+	return h
+}
+
+// validTo returns a copy of the holiday that only occurs until the given
+// year.
+func validTo(h Holiday, year int) Holiday {
+	h.ValidTo = year
+	return h
+}
+
+// periodic returns a copy of the holiday that only occurs every interval years
+// from the given year.
+func periodic(h Holiday, year, interval int) Holiday {
+	h.ValidFrom, h.Interval = year, interval
+	return h
+}
+
+// AddGermanStateHolidays adds all German holidays of the given state (such as
+// "BY" for Bavaria) to Calendar
+func AddGermanStateHolidays(c *Calendar, state string) error {
+	hs, ok := germanStateHolidays[state]
+	if !ok {
+		return fmt.Errorf("cal: unknown German state %q", state)
+	}
+	AddGermanHolidays(c)
+	c.AddHolidays(hs...)
+	return nil
+}
+
+// usStateHolidays holds the holidays of each US state and the District of
+// Columbia in addition to the federal ones, by USPS code. Only some of them
+// have holidays of their own.
+var usStateHolidays = map[string][]Holiday{
+	"AK": {US_SewardsDay, US_AlaskaDay},
+	"AL": {},
+	"AR": {},
+	"AZ": {},
+	"CA": {US_CesarChavez},
+	"CO": {},
+	"CT": {},
+	"DC": {US_DCEmancipation},
+	"DE": {},
+	"FL": {},
+	"GA": {},
+	"HI": {US_PrinceKuhio, US_Kamehameha, US_HawaiiStatehood},
+	"IA": {},
+	"ID": {},
+	"IL": {},
+	"IN": {},
+	"KS": {},
+	"KY": {},
+	"LA": {US_MardiGras},
+	"MA": {US_PatriotsDay},
+	"MD": {},
+	"ME": {US_PatriotsDay},
+	"MI": {},
+	"MN": {},
+	"MO": {},
+	"MS": {},
+	"MT": {},
+	"NC": {},
+	"ND": {},
+	"NE": {},
+	"NH": {},
+	"NJ": {},
+	"NM": {},
+	"NV": {},
+	"NY": {},
+	"OH": {},
+	"OK": {},
+	"OR": {},
+	"PA": {},
+	"RI": {},
+	"SC": {},
+	"SD": {},
+	"TN": {},
+	"TX": {US_TexasIndependence, US_SanJacinto},
+	"UT": {US_PioneerDay},
+	"VA": {},
+	"VT": {US_BenningtonBattle},
+	"WA": {},
+	"WI": {},
+	"WV": {},
+	"WY": {},
+}
+
+// AddUSStateHolidays adds all US federal holidays and those of the given
+// state (such as "MA" for Massachusetts) to Calendar
+func AddUSStateHolidays(c *Calendar, state string) error {
+	hs, ok := usStateHolidays[state]
+	if !ok {
+		return fmt.Errorf("cal: unknown US state %q", state)
+	}
+	AddUSHolidays(c)
+	c.AddHolidays(hs...)
+	return nil
 }
 
 //AddDutchHolidays adds all Dutch Holdays to Calendar
 func AddDutchHolidays(c *Calendar) {
-	c.AddHoliday(NLNieuwjaar)
-	c.AddHoliday(NLGoedeVrijdag)
-	c.AddHoliday(NLPaasMaandag)
-	c.AddHoliday(NLKoningsDag)
-	c.AddHoliday(NLBevrijdingsDag)
-	c.AddHoliday(NLHemelvaart)
-	c.AddHoliday(NLPinksterMaandag)
-	c.AddHoliday(NLEersteKerstdag)
-	c.AddHoliday(NLTweedeKerstdag)
+	c.AddHolidays(dutchHolidays...)
 }
 
 // AddBritishHolidays add all British holidays to Calender
 func AddBritishHolidays(c *Calendar) {
-	c.AddHoliday(GB_NewYear)
-	c.AddHoliday(GB_GoodFriday)
-	c.AddHoliday(GB_EasterMonday)
-	c.AddHoliday(GB_EarlyMay)
-	c.AddHoliday(GB_SpringHoliday)
-	c.AddHoliday(GB_SummerHoliday)
-	c.AddHoliday(GB_ChristmasDay)
-	c.AddHoliday(GB_BoxingDay)
+	c.AddHolidays(britishHolidays...)
+}
+
+// britishRegionHolidays holds the bank holidays of each nation of the United
+// Kingdom, by ISO 3166-2 code. The holidays added by AddBritishHolidays are
+// those of England and Wales.
+var britishRegionHolidays = map[string][]Holiday{
+	"ENG": britishHolidays,
+	"WLS": britishHolidays,
+	"SCT": {
+		GB_NewYear,
+		GB_SecondJanuary,
+		GB_GoodFriday,
+		GB_EarlyMay,
+		GB_SpringHoliday,
+		GB_SummerScotland,
+		GB_StAndrewsDay,
+		GB_ChristmasDay,
+		GB_BoxingDay,
+	},
+	"NIR": append([]Holiday{GB_StPatricksDay, GB_BattleOfTheBoyne}, britishHolidays...),
+}
+
+// AddBritishRegionHolidays adds all bank holidays of the given nation (such
+// as "SCT" for Scotland) to Calendar
+func AddBritishRegionHolidays(c *Calendar, region string) error {
+	hs, ok := britishRegionHolidays[region]
+	if !ok {
+		return fmt.Errorf("cal: unknown British region %q", region)
+	}
+	c.AddHolidays(hs...)
+	return nil
+}
+
+// NewUSCalendar creates a new Calendar with the US federal holidays, observed
+// on the nearest weekday.
+func NewUSCalendar() *Calendar {
+	c := NewCalendar()
+	c.Observed = ObservedNearest
+	AddUSHolidays(c)
+	return c
+}
+
+// NewECBCalendar creates a new Calendar with the Target2 closing days, which
+// are not moved when they fall on a weekend.
+func NewECBCalendar() *Calendar {
+	c := NewCalendar()
+	c.Observed = ObservedExact
+	AddECBHolidays(c)
+	return c
+}
+
+// NewGermanCalendar creates a new Calendar with the German holidays, which
+// are not moved when they fall on a weekend.
+func NewGermanCalendar() *Calendar {
+	c := NewCalendar()
+	c.Observed = ObservedExact
+	AddGermanHolidays(c)
+	return c
+}
+
+// NewDutchCalendar creates a new Calendar with the Dutch holidays, which are
+// not moved when they fall on a weekend.
+func NewDutchCalendar() *Calendar {
+	c := NewCalendar()
+	c.Observed = ObservedExact
+	AddDutchHolidays(c)
+	return c
+}
+
+// NewBritishCalendar creates a new Calendar with the British holidays, which
+// are substituted by the following Monday when they fall on a weekend.
+func NewBritishCalendar() *Calendar {
+	c := NewCalendar()
+	c.Observed = ObservedMonday
+	AddBritishHolidays(c)
+	return c
 }
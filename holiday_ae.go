@@ -0,0 +1,65 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in the United Arab Emirates
+//
+// The Islamic holidays follow the Islamic calendar; the government often
+// moves Islamic New Year and the Prophet's Birthday to join a weekend, which
+// is not reflected. Commemoration Day was kept on November 30th until 2018 and
+// has been kept on December 1st since.
+var (
+	AE_NewYear          = named(US_NewYear, "رأس السنة الميلادية")
+	AE_EidAlFitr1       = named(NewHolidayHijri(10, 1), "عيد الفطر")
+	AE_EidAlFitr2       = named(NewHolidayHijri(10, 2), "عيد الفطر")
+	AE_EidAlFitr3       = named(NewHolidayHijri(10, 3), "عيد الفطر")
+	AE_ArafatDay        = named(NewHolidayHijri(12, 9), "يوم عرفة")
+	AE_EidAlAdha1       = named(NewHolidayHijri(12, 10), "عيد الأضحى")
+	AE_EidAlAdha2       = named(NewHolidayHijri(12, 11), "عيد الأضحى")
+	AE_EidAlAdha3       = named(NewHolidayHijri(12, 12), "عيد الأضحى")
+	AE_IslamicNewYear   = named(NewHolidayHijri(1, 1), "رأس السنة الهجرية")
+	AE_ProphetsBirthday = named(NewHolidayHijri(3, 12), "المولد النبوي")
+	AE_CommemorationDay = validFrom(NewNamedHoliday("يوم الشهيد", time.December, 1), 2019)
+	AE_NationalDay      = validFrom(NewNamedHoliday("اليوم الوطني", time.December, 2), 1971)
+	AE_NationalDay2     = validFrom(NewNamedHoliday("اليوم الوطني", time.December, 3), 1971)
+)
+
+var emiratiHolidays = []Holiday{
+	AE_NewYear,
+	AE_EidAlFitr1,
+	AE_EidAlFitr2,
+	AE_EidAlFitr3,
+	AE_ArafatDay,
+	AE_EidAlAdha1,
+	AE_EidAlAdha2,
+	AE_EidAlAdha3,
+	AE_IslamicNewYear,
+	AE_ProphetsBirthday,
+	Holiday{Name: "يوم الشهيد", Month: time.November, Day: 30, ValidFrom: 2015, ValidTo: 2018},
+	AE_CommemorationDay,
+	AE_NationalDay,
+	AE_NationalDay2,
+}
+
+// AddEmiratiHolidays adds all Emirati holidays to Calendar
+func AddEmiratiHolidays(c *Calendar) {
+	c.AddHolidays(emiratiHolidays...)
+}
+
+// NewEmiratiCalendar creates a new Calendar with the Emirati holidays and the
+// work week in effect at each date: Saturday to Wednesday until August 2006,
+// Sunday to Thursday until 2021 and Monday to Friday since 2022. Holidays are
+// not moved when they fall on a weekend.
+func NewEmiratiCalendar() *Calendar {
+	c := NewCalendar()
+	c.SetWeekmask([7]bool{true, true, true, true, false, false, true})
+	c.SetWeekmaskFrom(time.Date(2006, time.September, 1, 0, 0, 0, 0, time.UTC),
+		[7]bool{true, true, true, true, true, false, false})
+	c.SetWeekmaskFrom(time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		[7]bool{false, true, true, true, true, true, false})
+	c.Observed = ObservedExact
+	AddEmiratiHolidays(c)
+	return c
+}
@@ -0,0 +1,71 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestEmiratiHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("AE")...)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 1, 1):  "رأس السنة الميلادية",
+		date(2024, 4, 10): "عيد الفطر",
+		date(2024, 4, 11): "عيد الفطر",
+		date(2024, 4, 12): "عيد الفطر",
+		date(2024, 6, 15): "يوم عرفة",
+		date(2024, 6, 16): "عيد الأضحى",
+		date(2024, 6, 17): "عيد الأضحى",
+		date(2024, 6, 18): "عيد الأضحى",
+		date(2024, 7, 7):  "رأس السنة الهجرية",
+		date(2024, 9, 15): "المولد النبوي",
+		date(2024, 12, 1): "يوم الشهيد",
+		date(2024, 12, 2): "اليوم الوطني",
+		date(2024, 12, 3): "اليوم الوطني",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2018, 11, 30), true},
+		{date(2018, 12, 1), false},
+		{date(2019, 11, 30), false},
+		{date(2019, 12, 1), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestEmiratiWorkweek(t *testing.T) {
+	c := cal.NewEmiratiCalendar()
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2005, 1, 6), false},   // Thursday
+		{date(2005, 1, 8), true},    // Saturday
+		{date(2021, 12, 30), true},  // Thursday
+		{date(2021, 12, 31), false}, // Friday
+		{date(2022, 1, 2), false},   // Sunday
+		{date(2022, 1, 7), true},    // Friday
+		{date(2023, 12, 4), true},   // National Day on Saturday is not moved
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Argentina
+//
+// The movable holidays (feriados trasladables) that fall on a Tuesday or
+// Wednesday are moved to the Monday before, and those that fall on a Thursday
+// or Friday to the Monday after. AddArgentineHolidays also adds the bridge
+// days (días no laborables con fines turísticos) decreed for each year.
+var (
+	AR_AnoNuevo             = named(US_NewYear, "Año Nuevo")
+	AR_CarnavalLunes        = named(NewHolidayEasterOffset(-48), "Carnaval")
+	AR_CarnavalMartes       = named(NewHolidayEasterOffset(-47), "Carnaval")
+	AR_DiaDeLaMemoria       = validFrom(NewNamedHoliday("Día Nacional de la Memoria por la Verdad y la Justicia", time.March, 24), 2006)
+	AR_Malvinas             = NewNamedHoliday("Día del Veterano y de los Caídos en la Guerra de Malvinas", time.April, 2)
+	AR_ViernesSanto         = named(ECB_GoodFriday, "Viernes Santo")
+	AR_DiaDelTrabajador     = named(ECB_LabourDay, "Día del Trabajador")
+	AR_RevolucionDeMayo     = NewNamedHoliday("Día de la Revolución de Mayo", time.May, 25)
+	AR_Guemes               = validFrom(NewNamedHolidayFunc("Paso a la Inmortalidad del General Martín Miguel de Güemes", calculateGuemes), 2016)
+	AR_Belgrano             = NewNamedHoliday("Paso a la Inmortalidad del General Manuel Belgrano", time.June, 20)
+	AR_Independencia        = NewNamedHoliday("Día de la Independencia", time.July, 9)
+	AR_SanMartin            = NewNamedHolidayFunc("Paso a la Inmortalidad del General José de San Martín", calculateSanMartin)
+	AR_DiversidadCultural   = NewNamedHolidayFunc("Día del Respeto a la Diversidad Cultural", calculateDiversidadCultural)
+	AR_SoberaniaNacional    = validFrom(NewNamedHolidayFunc("Día de la Soberanía Nacional", calculateSoberaniaNacional), 2010)
+	AR_InmaculadaConcepcion = named(catholicImmaculate, "Inmaculada Concepción de María")
+	AR_Navidad              = named(ECB_ChristmasDay, "Navidad")
+)
+
+var argentineHolidays = []Holiday{
+	AR_AnoNuevo,
+	AR_CarnavalLunes,
+	AR_CarnavalMartes,
+	AR_DiaDeLaMemoria,
+	AR_Malvinas,
+	AR_ViernesSanto,
+	AR_DiaDelTrabajador,
+	AR_RevolucionDeMayo,
+	AR_Guemes,
+	AR_Belgrano,
+	AR_Independencia,
+	AR_SanMartin,
+	AR_DiversidadCultural,
+	AR_SoberaniaNacional,
+	AR_InmaculadaConcepcion,
+	AR_Navidad,
+}
+
+// argentineBridgeDays holds the bridge days decreed by the national
+// government, by year.
+var argentineBridgeDays = map[int][]time.Time{
+	2023: {ymd(2023, time.May, 26), ymd(2023, time.June, 19), ymd(2023, time.October, 13)},
+	2024: {ymd(2024, time.April, 1), ymd(2024, time.June, 21), ymd(2024, time.October, 11)},
+	2025: {ymd(2025, time.May, 2), ymd(2025, time.August, 15), ymd(2025, time.November, 21)},
+}
+
+// argentineMovable reports the day on which a movable holiday of the given
+// date is kept.
+func argentineMovable(year int, month time.Month, day int, loc *time.Location) (time.Month, int) {
+	d := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	switch d.Weekday() {
+	case time.Tuesday, time.Wednesday:
+		d = WeekdayOnOrBefore(d, time.Monday)
+	case time.Thursday, time.Friday:
+		d = WeekdayOnOrAfter(d, time.Monday)
+	}
+	return d.Month(), d.Day()
+}
+
+// Güemes Day is June 17th, moved to a Monday.
+func calculateGuemes(year int, loc *time.Location) (time.Month, int) {
+	return argentineMovable(year, time.June, 17, loc)
+}
+
+// San Martín Day is August 17th, moved to a Monday.
+func calculateSanMartin(year int, loc *time.Location) (time.Month, int) {
+	return argentineMovable(year, time.August, 17, loc)
+}
+
+// The Day of Respect for Cultural Diversity is October 12th, moved to a
+// Monday.
+func calculateDiversidadCultural(year int, loc *time.Location) (time.Month, int) {
+	return argentineMovable(year, time.October, 12, loc)
+}
+
+// National Sovereignty Day is November 20th, moved to a Monday.
+func calculateSoberaniaNacional(year int, loc *time.Location) (time.Month, int) {
+	return argentineMovable(year, time.November, 20, loc)
+}
+
+// AddArgentineBridgeDays adds bridge days decreed by the national government
+// to Calendar, such as those of a decree published after this package. Days
+// that already have a holiday are left out.
+func AddArgentineBridgeDays(c *Calendar, days ...time.Time) {
+	for _, d := range days {
+		if !c.IsHoliday(d) {
+			c.AddHoliday(named(NewHolidayExact(d.Year(), d.Month(), d.Day()), "Día no laborable con fines turísticos"))
+		}
+	}
+}
+
+// AddArgentineHolidays adds all Argentine holidays to Calendar, along with the
+// bridge days of the known decrees
+func AddArgentineHolidays(c *Calendar) {
+	c.AddHolidays(argentineHolidays...)
+	for _, days := range argentineBridgeDays {
+		AddArgentineBridgeDays(c, days...)
+	}
+}
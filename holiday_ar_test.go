@@ -0,0 +1,70 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestArgentineHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("AR")...)
+
+	caltest.AssertHolidays(t, c, 2025, map[time.Time]string{
+		date(2025, 1, 1):   "Año Nuevo",
+		date(2025, 3, 3):   "Carnaval",
+		date(2025, 3, 4):   "Carnaval",
+		date(2025, 3, 24):  "Día Nacional de la Memoria por la Verdad y la Justicia",
+		date(2025, 4, 2):   "Día del Veterano y de los Caídos en la Guerra de Malvinas",
+		date(2025, 4, 18):  "Viernes Santo",
+		date(2025, 5, 1):   "Día del Trabajador",
+		date(2025, 5, 25):  "Día de la Revolución de Mayo",
+		date(2025, 6, 16):  "Paso a la Inmortalidad del General Martín Miguel de Güemes",
+		date(2025, 6, 20):  "Paso a la Inmortalidad del General Manuel Belgrano",
+		date(2025, 7, 9):   "Día de la Independencia",
+		date(2025, 8, 17):  "Paso a la Inmortalidad del General José de San Martín",
+		date(2025, 10, 12): "Día del Respeto a la Diversidad Cultural",
+		date(2025, 11, 24): "Día de la Soberanía Nacional",
+		date(2025, 12, 8):  "Inmaculada Concepción de María",
+		date(2025, 12, 25): "Navidad",
+	})
+}
+
+func TestArgentineBridgeDays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddArgentineHolidays(c)
+	cal.AddArgentineBridgeDays(c, date(2099, 3, 23), date(2099, 5, 25))
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 4, 1), true},   // bridge day before Malvinas
+		{date(2024, 6, 21), true},  // bridge day after Belgrano
+		{date(2024, 6, 17), true},  // Güemes on a Monday
+		{date(2024, 10, 11), true}, // bridge day before Diversidad Cultural
+		{date(2024, 10, 12), true}, // Diversidad Cultural on Saturday
+		{date(2023, 8, 21), true},  // San Martín on Thursday
+		{date(2023, 8, 17), false},
+		{date(2099, 3, 23), true},
+		{date(2025, 11, 20), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	if name, _ := c.HolidayName(date(2099, 5, 25)); name != "Día de la Revolución de Mayo" {
+		t.Errorf("got: %q; want the holiday already on the day", name)
+	}
+	other := cal.NewCalendar()
+	cal.AddArgentineHolidays(other)
+	if other.IsHoliday(date(2099, 3, 23)) {
+		t.Errorf("Expected the bridge days of one calendar not to affect another")
+	}
+}
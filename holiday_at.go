@@ -0,0 +1,45 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Austria
+//
+// Holidays on a Saturday or Sunday are lost rather than moved to a weekday.
+var (
+	AT_Neujahr            = observedExact(named(US_NewYear, "Neujahr"))
+	AT_HeiligeDreiKoenige = observedExact(named(catholicEpiphany, "Heilige Drei Könige"))
+	AT_Ostermontag        = observedExact(named(ECB_EasterMonday, "Ostermontag"))
+	AT_Staatsfeiertag     = observedExact(named(ECB_LabourDay, "Staatsfeiertag"))
+	AT_ChristiHimmelfahrt = observedExact(named(catholicAscension, "Christi Himmelfahrt"))
+	AT_Pfingstmontag      = observedExact(named(catholicWhitMonday, "Pfingstmontag"))
+	AT_Fronleichnam       = observedExact(named(catholicCorpusChristi, "Fronleichnam"))
+	AT_MariaHimmelfahrt   = observedExact(named(catholicAssumption, "Mariä Himmelfahrt"))
+	AT_Nationalfeiertag   = observedExact(NewNamedHoliday("Nationalfeiertag", time.October, 26))
+	AT_Allerheiligen      = observedExact(named(catholicAllSaints, "Allerheiligen"))
+	AT_MariaEmpfaengnis   = observedExact(named(catholicImmaculate, "Mariä Empfängnis"))
+	AT_Christtag          = observedExact(named(ECB_ChristmasDay, "Christtag"))
+	AT_Stefanitag         = observedExact(named(ECB_ChristmasHoliday, "Stefanitag"))
+)
+
+var austrianHolidays = []Holiday{
+	AT_Neujahr,
+	AT_HeiligeDreiKoenige,
+	AT_Ostermontag,
+	AT_Staatsfeiertag,
+	AT_ChristiHimmelfahrt,
+	AT_Pfingstmontag,
+	AT_Fronleichnam,
+	AT_MariaHimmelfahrt,
+	AT_Nationalfeiertag,
+	AT_Allerheiligen,
+	AT_MariaEmpfaengnis,
+	AT_Christtag,
+	AT_Stefanitag,
+}
+
+// AddAustrianHolidays adds all Austrian holidays to Calendar
+func AddAustrianHolidays(c *Calendar) {
+	c.AddHolidays(austrianHolidays...)
+}
@@ -0,0 +1,51 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestAustrianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddAustrianHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2021, map[time.Time]string{
+		date(2021, 1, 1):   "Neujahr",
+		date(2021, 1, 6):   "Heilige Drei Könige",
+		date(2021, 4, 5):   "Ostermontag",
+		date(2021, 5, 1):   "Staatsfeiertag",
+		date(2021, 5, 13):  "Christi Himmelfahrt",
+		date(2021, 5, 24):  "Pfingstmontag",
+		date(2021, 6, 3):   "Fronleichnam",
+		date(2021, 8, 15):  "Mariä Himmelfahrt",
+		date(2021, 10, 26): "Nationalfeiertag",
+		date(2021, 11, 1):  "Allerheiligen",
+		date(2021, 12, 8):  "Mariä Empfängnis",
+		date(2021, 12, 25): "Christtag",
+		date(2021, 12, 26): "Stefanitag",
+	})
+}
+
+func TestAustrianWorkdays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddAustrianHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2019, 10, 25), true}, // Friday before Nationalfeiertag
+		{date(2020, 11, 2), true},  // Monday after Allerheiligen
+		{date(2021, 12, 27), true}, // Monday after Stefanitag
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
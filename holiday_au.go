@@ -0,0 +1,83 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Holidays in Australia
+//
+// A holiday falling on a weekend is given a substitute day on the following
+// Monday, or the Tuesday when the Monday is already a holiday. ANZAC Day is
+// kept on the day itself.
+var (
+	AU_NewYear        = NewHolidayObserved(named(US_NewYear, "New Year's Day"), ObservedMonday)
+	AU_AustraliaDay   = NewHolidayObserved(NewNamedHoliday("Australia Day", time.January, 26), ObservedMonday)
+	AU_GoodFriday     = named(ECB_GoodFriday, "Good Friday")
+	AU_EasterMonday   = named(ECB_EasterMonday, "Easter Monday")
+	AU_AnzacDay       = NewHolidayObserved(NewNamedHoliday("ANZAC Day", time.April, 25), ObservedExact)
+	AU_ChristmasDay   = NewHolidayObserved(named(ECB_ChristmasDay, "Christmas Day"), ObservedMonday)
+	AU_BoxingDay      = NewHolidayObserved(named(ECB_ChristmasHoliday, "Boxing Day"), ObservedMonday)
+	AU_QueensBirthday = Holiday{Name: "Queen's Birthday", Month: time.June, Weekday: time.Monday, Offset: 2, ValidTo: 2022}
+	AU_KingsBirthday  = Holiday{Name: "King's Birthday", Month: time.June, Weekday: time.Monday, Offset: 2, ValidFrom: 2023}
+
+	// Holidays in some Australian states and territories
+	AU_EasterSaturday  = named(NewHolidayEasterOffset(-1), "Easter Saturday")
+	AU_LabourDayMarch1 = NewNamedHolidayFloat("Labour Day", time.March, time.Monday, 1)
+	AU_LabourDayMarch2 = NewNamedHolidayFloat("Labour Day", time.March, time.Monday, 2)
+	AU_LabourDayMay    = NewNamedHolidayFloat("Labour Day", time.May, time.Monday, 1)
+	AU_LabourDayOct    = NewNamedHolidayFloat("Labour Day", time.October, time.Monday, 1)
+	AU_CanberraDay     = NewNamedHolidayFloat("Canberra Day", time.March, time.Monday, 2)
+	AU_AdelaideCup     = NewNamedHolidayFloat("Adelaide Cup Day", time.March, time.Monday, 2)
+	AU_WADay           = NewNamedHolidayFloat("Western Australia Day", time.June, time.Monday, 1)
+	AU_PicnicDay       = NewNamedHolidayFloat("Picnic Day", time.August, time.Monday, 1)
+	AU_MelbourneCup    = NewNamedHolidayFloat("Melbourne Cup Day", time.November, time.Tuesday, 1)
+)
+
+var australianHolidays = []Holiday{
+	AU_NewYear,
+	AU_AustraliaDay,
+	AU_GoodFriday,
+	AU_EasterMonday,
+	AU_AnzacDay,
+	AU_ChristmasDay,
+	AU_BoxingDay,
+}
+
+// australianStateHolidays holds the holidays of each state and territory in
+// addition to the national ones, by state code. The sovereign's birthday is
+// kept in June except in Queensland and Western Australia.
+var australianStateHolidays = map[string][]Holiday{
+	"ACT": {AU_EasterSaturday, AU_CanberraDay, AU_QueensBirthday, AU_KingsBirthday, AU_LabourDayOct},
+	"NSW": {AU_EasterSaturday, AU_QueensBirthday, AU_KingsBirthday, AU_LabourDayOct},
+	"NT":  {AU_EasterSaturday, named(AU_LabourDayMay, "May Day"), AU_QueensBirthday, AU_KingsBirthday, AU_PicnicDay},
+	"QLD": {
+		AU_EasterSaturday, AU_LabourDayMay,
+		Holiday{Name: "Queen's Birthday", Month: time.June, Weekday: time.Monday, Offset: 2, ValidTo: 2015},
+		Holiday{Name: "Queen's Birthday", Month: time.October, Weekday: time.Monday, Offset: 1, ValidFrom: 2016, ValidTo: 2022},
+		Holiday{Name: "King's Birthday", Month: time.October, Weekday: time.Monday, Offset: 1, ValidFrom: 2023},
+	},
+	"SA":  {AU_EasterSaturday, AU_AdelaideCup, AU_QueensBirthday, AU_KingsBirthday, AU_LabourDayOct},
+	"TAS": {named(AU_LabourDayMarch2, "Eight Hours Day"), AU_QueensBirthday, AU_KingsBirthday},
+	"VIC": {AU_EasterSaturday, AU_LabourDayMarch2, AU_QueensBirthday, AU_KingsBirthday, AU_MelbourneCup},
+	"WA": {
+		AU_LabourDayMarch1, AU_WADay,
+		Holiday{Name: "Queen's Birthday", Month: time.September, Weekday: time.Monday, Offset: -1, ValidTo: 2022},
+		Holiday{Name: "King's Birthday", Month: time.September, Weekday: time.Monday, Offset: -1, ValidFrom: 2023},
+	},
+}
+
+// AddAustralianHolidays adds all Australian national holidays to Calendar,
+// along with those of the given state or territory (such as "VIC" for
+// Victoria). An empty state adds the national holidays only.
+func AddAustralianHolidays(c *Calendar, state string) error {
+	hs, ok := australianStateHolidays[state]
+	if !ok && state != "" {
+		return fmt.Errorf("cal: unknown Australian state %q", state)
+	}
+	c.AddHolidays(australianHolidays...)
+	c.AddHolidays(hs...)
+	return nil
+}
@@ -0,0 +1,86 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestAustralianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddAustralianHolidays(c, "VIC"); err != nil {
+		t.Fatal(err)
+	}
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "New Year's Day",
+		date(2023, 1, 26):  "Australia Day",
+		date(2023, 3, 13):  "Labour Day",
+		date(2023, 4, 7):   "Good Friday",
+		date(2023, 4, 8):   "Easter Saturday",
+		date(2023, 4, 10):  "Easter Monday",
+		date(2023, 4, 25):  "ANZAC Day",
+		date(2023, 6, 12):  "King's Birthday",
+		date(2023, 11, 7):  "Melbourne Cup Day",
+		date(2023, 12, 25): "Christmas Day",
+		date(2023, 12, 26): "Boxing Day",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2023, 1, 2), false},   // New Year's Day substitute
+		{date(2020, 4, 27), true},   // ANZAC Day on Saturday keeps no substitute
+		{date(2021, 12, 27), false}, // Christmas Day substitute
+		{date(2021, 12, 28), false}, // Boxing Day substitute
+		{date(2021, 12, 24), true},  // no Friday substitute
+		{date(2022, 12, 27), false}, // Christmas Day substitute after Boxing Day
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestAustralianStateHolidays(t *testing.T) {
+	tests := []struct {
+		state string
+		t     time.Time
+		want  bool
+	}{
+		{"NSW", date(2023, 10, 2), true},  // Labour Day
+		{"NSW", date(2023, 3, 13), false}, // Labour Day in Victoria
+		{"QLD", date(2023, 5, 1), true},   // Labour Day
+		{"QLD", date(2023, 10, 2), true},  // King's Birthday
+		{"QLD", date(2015, 6, 8), true},   // Queen's Birthday
+		{"QLD", date(2023, 6, 12), false},
+		{"WA", date(2023, 9, 25), true},  // King's Birthday
+		{"WA", date(2023, 3, 6), true},   // Labour Day
+		{"WA", date(2023, 4, 8), false},  // Easter Saturday
+		{"SA", date(2023, 3, 13), true},  // Adelaide Cup Day
+		{"ACT", date(2023, 3, 13), true}, // Canberra Day
+		{"TAS", date(2022, 6, 13), true}, // Queen's Birthday
+		{"NT", date(2023, 8, 7), true},   // Picnic Day
+	}
+
+	for _, test := range tests {
+		c := cal.NewCalendar()
+		if err := cal.AddAustralianHolidays(c, test.state); err != nil {
+			t.Fatal(err)
+		}
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s %s)", got, test.want, test.state, test.t)
+		}
+	}
+
+	if err := cal.AddAustralianHolidays(cal.NewCalendar(), "XX"); err == nil {
+		t.Error("got: nil; want: error for unknown state")
+	}
+}
@@ -0,0 +1,41 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Belgium
+//
+// An employer grants a replacement day for a holiday on a Sunday or a
+// non-working day, but it is agreed per company, so holidays are kept on
+// their own day.
+var (
+	BE_Nieuwjaar         = observedExact(named(US_NewYear, "Nieuwjaar"))
+	BE_Paasmaandag       = observedExact(named(ECB_EasterMonday, "Paasmaandag"))
+	BE_DagVanDeArbeid    = observedExact(named(ECB_LabourDay, "Dag van de Arbeid"))
+	BE_OLHHemelvaart     = observedExact(named(catholicAscension, "O.L.H. Hemelvaart"))
+	BE_Pinkstermaandag   = observedExact(named(catholicWhitMonday, "Pinkstermaandag"))
+	BE_NationaleFeestdag = observedExact(NewNamedHoliday("Nationale Feestdag", time.July, 21))
+	BE_OLVHemelvaart     = observedExact(named(catholicAssumption, "O.L.V. Hemelvaart"))
+	BE_Allerheiligen     = observedExact(named(catholicAllSaints, "Allerheiligen"))
+	BE_Wapenstilstand    = observedExact(NewNamedHoliday("Wapenstilstand", time.November, 11))
+	BE_Kerstmis          = observedExact(named(ECB_ChristmasDay, "Kerstmis"))
+)
+
+var belgianHolidays = []Holiday{
+	BE_Nieuwjaar,
+	BE_Paasmaandag,
+	BE_DagVanDeArbeid,
+	BE_OLHHemelvaart,
+	BE_Pinkstermaandag,
+	BE_NationaleFeestdag,
+	BE_OLVHemelvaart,
+	BE_Allerheiligen,
+	BE_Wapenstilstand,
+	BE_Kerstmis,
+}
+
+// AddBelgianHolidays adds all Belgian holidays to Calendar
+func AddBelgianHolidays(c *Calendar) {
+	c.AddHolidays(belgianHolidays...)
+}
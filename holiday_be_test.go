@@ -0,0 +1,48 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestBelgianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddBelgianHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2022, map[time.Time]string{
+		date(2022, 1, 1):   "Nieuwjaar",
+		date(2022, 4, 18):  "Paasmaandag",
+		date(2022, 5, 1):   "Dag van de Arbeid",
+		date(2022, 5, 26):  "O.L.H. Hemelvaart",
+		date(2022, 6, 6):   "Pinkstermaandag",
+		date(2022, 7, 21):  "Nationale Feestdag",
+		date(2022, 8, 15):  "O.L.V. Hemelvaart",
+		date(2022, 11, 1):  "Allerheiligen",
+		date(2022, 11, 11): "Wapenstilstand",
+		date(2022, 12, 25): "Kerstmis",
+	})
+}
+
+func TestBelgianWorkdays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddBelgianHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2018, 7, 20), true},  // Friday before Nationale Feestdag
+		{date(2019, 7, 22), true},  // Monday after Nationale Feestdag
+		{date(2018, 11, 12), true}, // Monday after Wapenstilstand
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
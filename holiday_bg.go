@@ -0,0 +1,55 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Bulgaria
+//
+// A holiday other than Easter that falls on a weekend gives a day off on the
+// next work day. The Easter holidays follow the Orthodox Easter whatever the
+// EasterMethod of the calendar.
+var (
+	BG_NovaGodina      = bgHoliday(named(US_NewYear, "Нова година"))
+	BG_Osvobozhdenie   = bgHoliday(NewNamedHoliday("Ден на Освобождението на България от османско иго", time.March, 3))
+	BG_RazpetiPetak    = bgHoliday(named(NewHolidayOrthodoxEasterOffset(-2), "Разпети петък"))
+	BG_VelikaSabota    = NewHolidayObserved(named(NewHolidayOrthodoxEasterOffset(-1), "Велика събота"), ObservedExact)
+	BG_Velikden        = NewHolidayObserved(named(NewHolidayOrthodoxEasterOffset(0), "Великден"), ObservedExact)
+	BG_Velikden2       = bgHoliday(named(NewHolidayOrthodoxEasterOffset(1), "Великден"))
+	BG_DenNaTruda      = bgHoliday(named(ECB_LabourDay, "Ден на труда"))
+	BG_Gergyovden      = bgHoliday(NewNamedHoliday("Гергьовден", time.May, 6))
+	BG_DenNaProsvetata = bgHoliday(NewNamedHoliday("Ден на светите братя Кирил и Методий", time.May, 24))
+	BG_Saedinenie      = bgHoliday(NewNamedHoliday("Ден на Съединението", time.September, 6))
+	BG_Nezavisimost    = bgHoliday(NewNamedHoliday("Ден на Независимостта на България", time.September, 22))
+	BG_BadniVecher     = bgHoliday(NewNamedHoliday("Бъдни вечер", time.December, 24))
+	BG_Koleda          = bgHoliday(named(ECB_ChristmasDay, "Рождество Христово"))
+	BG_Koleda2         = bgHoliday(named(ECB_ChristmasHoliday, "Рождество Христово"))
+)
+
+var bulgarianHolidays = []Holiday{
+	BG_NovaGodina,
+	BG_Osvobozhdenie,
+	BG_RazpetiPetak,
+	BG_VelikaSabota,
+	BG_Velikden,
+	BG_Velikden2,
+	BG_DenNaTruda,
+	BG_Gergyovden,
+	BG_DenNaProsvetata,
+	BG_Saedinenie,
+	BG_Nezavisimost,
+	BG_BadniVecher,
+	BG_Koleda,
+	BG_Koleda2,
+}
+
+// bgHoliday returns a copy of the holiday that is moved from a weekend to the
+// next work day.
+func bgHoliday(h Holiday) Holiday {
+	return NewHolidayObserved(h, ObservedMonday)
+}
+
+// AddBulgarianHolidays adds all Bulgarian holidays to Calendar
+func AddBulgarianHolidays(c *Calendar) {
+	c.AddHolidays(bulgarianHolidays...)
+}
@@ -0,0 +1,54 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestBulgarianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("BG")...)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 1, 1):   "Нова година",
+		date(2024, 3, 3):   "Ден на Освобождението на България от османско иго",
+		date(2024, 5, 1):   "Ден на труда",
+		date(2024, 5, 3):   "Разпети петък",
+		date(2024, 5, 4):   "Велика събота",
+		date(2024, 5, 5):   "Великден",
+		date(2024, 5, 6):   "Великден",
+		date(2024, 5, 24):  "Ден на светите братя Кирил и Методий",
+		date(2024, 9, 6):   "Ден на Съединението",
+		date(2024, 9, 22):  "Ден на Независимостта на България",
+		date(2024, 12, 24): "Бъдни вечер",
+		date(2024, 12, 25): "Рождество Христово",
+		date(2024, 12, 26): "Рождество Христово",
+	})
+}
+
+func TestBulgarianSubstitutes(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddBulgarianHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 3, 4), false},   // Освобождението on Sunday
+		{date(2024, 5, 7), true},    // Великден on Sunday is not moved
+		{date(2024, 9, 23), false},  // Независимостта on Sunday
+		{date(2022, 12, 27), false}, // Бъдни вечер on Saturday
+		{date(2022, 12, 28), false}, // Коледа on Sunday
+		{date(2022, 12, 29), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
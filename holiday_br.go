@@ -0,0 +1,52 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Brazil
+//
+// Municipal holidays are not added by AddBrazilianHolidays; add those of the
+// city to the calendar where they are kept.
+var (
+	BR_ConfraternizacaoUniversal = named(US_NewYear, "Confraternização Universal")
+	BR_CarnavalSegunda           = named(NewHolidayEasterOffset(-48), "Carnaval")
+	BR_CarnavalTerca             = named(NewHolidayEasterOffset(-47), "Carnaval")
+	BR_SextaFeiraSanta           = named(ECB_GoodFriday, "Sexta-feira Santa")
+	BR_Tiradentes                = NewNamedHoliday("Tiradentes", time.April, 21)
+	BR_DiaDoTrabalho             = named(ECB_LabourDay, "Dia do Trabalho")
+	BR_CorpusChristi             = named(catholicCorpusChristi, "Corpus Christi")
+	BR_Independencia             = NewNamedHoliday("Independência do Brasil", time.September, 7)
+	BR_NossaSenhoraAparecida     = validFrom(NewNamedHoliday("Nossa Senhora Aparecida", time.October, 12), 1980)
+	BR_Finados                   = NewNamedHoliday("Finados", time.November, 2)
+	BR_ProclamacaoRepublica      = NewNamedHoliday("Proclamação da República", time.November, 15)
+	BR_ConscienciaNegra          = validFrom(NewNamedHoliday("Dia Nacional de Zumbi e da Consciência Negra", time.November, 20), 2024)
+	BR_Natal                     = named(ECB_ChristmasDay, "Natal")
+
+	// Municipal holidays
+	BR_SaoSebastiaoRio      = NewNamedHoliday("Dia de São Sebastião", time.January, 20)
+	BR_AniversarioSaoPaulo  = NewNamedHoliday("Aniversário de São Paulo", time.January, 25)
+	BR_AniversarioBrasilia  = validFrom(NewNamedHoliday("Aniversário de Brasília", time.April, 21), 1960)
+	BR_NossaSenhoraSalvador = NewNamedHoliday("Nossa Senhora da Conceição da Praia", time.December, 8)
+)
+
+var brazilianHolidays = []Holiday{
+	BR_ConfraternizacaoUniversal,
+	BR_CarnavalSegunda,
+	BR_CarnavalTerca,
+	BR_SextaFeiraSanta,
+	BR_Tiradentes,
+	BR_DiaDoTrabalho,
+	BR_CorpusChristi,
+	BR_Independencia,
+	BR_NossaSenhoraAparecida,
+	BR_Finados,
+	BR_ProclamacaoRepublica,
+	BR_ConscienciaNegra,
+	BR_Natal,
+}
+
+// AddBrazilianHolidays adds all Brazilian holidays to Calendar
+func AddBrazilianHolidays(c *Calendar) {
+	c.AddHolidays(brazilianHolidays...)
+}
@@ -0,0 +1,53 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestBrazilianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("BR")...)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 1, 1):   "Confraternização Universal",
+		date(2024, 2, 12):  "Carnaval",
+		date(2024, 2, 13):  "Carnaval",
+		date(2024, 3, 29):  "Sexta-feira Santa",
+		date(2024, 4, 21):  "Tiradentes",
+		date(2024, 5, 1):   "Dia do Trabalho",
+		date(2024, 5, 30):  "Corpus Christi",
+		date(2024, 9, 7):   "Independência do Brasil",
+		date(2024, 10, 12): "Nossa Senhora Aparecida",
+		date(2024, 11, 2):  "Finados",
+		date(2024, 11, 15): "Proclamação da República",
+		date(2024, 11, 20): "Dia Nacional de Zumbi e da Consciência Negra",
+		date(2024, 12, 25): "Natal",
+	})
+}
+
+func TestBrazilianMunicipalHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddBrazilianHolidays(c)
+	c.AddHoliday(cal.BR_AniversarioSaoPaulo)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2023, 1, 25), true},   // Aniversário de São Paulo
+		{date(2023, 1, 20), false},  // Dia de São Sebastião in Rio only
+		{date(2023, 11, 20), false}, // before Consciência Negra was national
+		{date(2025, 3, 4), true},    // Carnaval
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Holidays in Canada
+//
+// A holiday on a fixed date that falls on a weekend is observed on the
+// following Monday, or the Tuesday when the Monday is already a holiday. The
+// Fête nationale du Québec only moves from a Sunday.
+var (
+	CA_NewYear        = NewHolidayObserved(named(US_NewYear, "New Year's Day"), ObservedMonday)
+	CA_GoodFriday     = named(ECB_GoodFriday, "Good Friday")
+	CA_CanadaDay      = NewHolidayObserved(NewNamedHoliday("Canada Day", time.July, 1), ObservedMonday)
+	CA_LabourDay      = NewNamedHolidayFloat("Labour Day", time.September, time.Monday, 1)
+	CA_TruthDay       = NewHolidayObserved(validFrom(NewNamedHoliday("National Day for Truth and Reconciliation", time.September, 30), 2021), ObservedMonday)
+	CA_Thanksgiving   = NewNamedHolidayFloat("Thanksgiving", time.October, time.Monday, 2)
+	CA_RemembranceDay = NewHolidayObserved(NewNamedHoliday("Remembrance Day", time.November, 11), ObservedMonday)
+	CA_ChristmasDay   = NewHolidayObserved(named(ECB_ChristmasDay, "Christmas Day"), ObservedMonday)
+	CA_BoxingDay      = NewHolidayObserved(named(ECB_ChristmasHoliday, "Boxing Day"), ObservedMonday)
+
+	// Holidays in some Canadian provinces and territories
+	CA_FamilyDay            = NewNamedHolidayFloat("Family Day", time.February, time.Monday, 3)
+	CA_LouisRielDay         = validFrom(NewNamedHolidayFloat("Louis Riel Day", time.February, time.Monday, 3), 2008)
+	CA_IslanderDay          = validFrom(NewNamedHolidayFloat("Islander Day", time.February, time.Monday, 3), 2009)
+	CA_HeritageDay          = validFrom(NewNamedHolidayFloat("Heritage Day", time.February, time.Monday, 3), 2015)
+	CA_PatriotsDay          = named(CA_VictoriaDay, "National Patriots' Day")
+	CA_IndigenousPeoplesDay = NewHolidayObserved(NewNamedHoliday("National Indigenous Peoples Day", time.June, 21), ObservedMonday)
+	CA_StJeanBaptiste       = NewHolidayObserved(NewNamedHoliday("Fête nationale du Québec", time.June, 24), ObservedSundayToMonday)
+	CA_NunavutDay           = NewHolidayObserved(NewNamedHoliday("Nunavut Day", time.July, 9), ObservedMonday)
+	CA_CivicHoliday         = NewNamedHolidayFloat("Civic Holiday", time.August, time.Monday, 1)
+	CA_DiscoveryDay         = NewNamedHolidayFloat("Discovery Day", time.August, time.Monday, 3)
+)
+
+var canadianHolidays = []Holiday{
+	CA_NewYear,
+	CA_GoodFriday,
+	CA_VictoriaDay,
+	CA_CanadaDay,
+	CA_LabourDay,
+	CA_TruthDay,
+	CA_Thanksgiving,
+	CA_RemembranceDay,
+	CA_ChristmasDay,
+	CA_BoxingDay,
+}
+
+// canadianNationwideHolidays are the holidays kept by every province.
+var canadianNationwideHolidays = []Holiday{
+	CA_NewYear,
+	CA_GoodFriday,
+	CA_CanadaDay,
+	CA_LabourDay,
+	CA_ChristmasDay,
+}
+
+// canadianProvinceHolidays holds the statutory holidays of each province and
+// territory in addition to the nationwide ones, by province code.
+var canadianProvinceHolidays = map[string][]Holiday{
+	"AB": {
+		validFrom(CA_FamilyDay, 1990), CA_VictoriaDay, CA_Thanksgiving,
+		CA_RemembranceDay,
+	},
+	"BC": {
+		Holiday{Name: "Family Day", Month: time.February, Weekday: time.Monday, Offset: 2, ValidFrom: 2013, ValidTo: 2018},
+		validFrom(CA_FamilyDay, 2019), CA_VictoriaDay, named(CA_CivicHoliday, "British Columbia Day"),
+		validFrom(CA_TruthDay, 2023), CA_Thanksgiving, CA_RemembranceDay,
+	},
+	"MB": {
+		CA_LouisRielDay, CA_VictoriaDay, validFrom(CA_TruthDay, 2023),
+		CA_Thanksgiving, CA_RemembranceDay,
+	},
+	"NB": {
+		validFrom(CA_FamilyDay, 2018), named(CA_CivicHoliday, "New Brunswick Day"),
+		CA_RemembranceDay,
+	},
+	"NL": {CA_RemembranceDay},
+	"NS": {CA_HeritageDay},
+	"NT": {
+		CA_VictoriaDay, CA_IndigenousPeoplesDay, CA_CivicHoliday,
+		validFrom(CA_TruthDay, 2022), CA_Thanksgiving, CA_RemembranceDay,
+	},
+	"NU": {
+		CA_VictoriaDay, validFrom(CA_NunavutDay, 2001), CA_CivicHoliday,
+		CA_Thanksgiving, CA_RemembranceDay, CA_BoxingDay,
+	},
+	"ON": {
+		validFrom(CA_FamilyDay, 2008), CA_VictoriaDay, CA_Thanksgiving,
+		CA_BoxingDay,
+	},
+	"PE": {
+		CA_IslanderDay, validFrom(CA_TruthDay, 2022), CA_RemembranceDay,
+	},
+	"QC": {
+		CA_PatriotsDay, CA_StJeanBaptiste, CA_Thanksgiving,
+	},
+	"SK": {
+		validFrom(CA_FamilyDay, 2007), CA_VictoriaDay, named(CA_CivicHoliday, "Saskatchewan Day"),
+		CA_Thanksgiving, CA_RemembranceDay,
+	},
+	"YT": {
+		CA_VictoriaDay, validFrom(CA_IndigenousPeoplesDay, 2017), CA_DiscoveryDay,
+		validFrom(CA_TruthDay, 2023), CA_Thanksgiving, CA_RemembranceDay,
+	},
+}
+
+// AddCanadianHolidays adds the holidays of the given province or territory
+// (such as "QC" for Quebec or "YT" for Yukon) to Calendar. An empty province
+// adds the federal statutory holidays.
+func AddCanadianHolidays(c *Calendar, province string) error {
+	if province == "" {
+		c.AddHolidays(canadianHolidays...)
+		return nil
+	}
+	hs, ok := canadianProvinceHolidays[province]
+	if !ok {
+		return fmt.Errorf("cal: unknown Canadian province %q", province)
+	}
+	c.AddHolidays(canadianNationwideHolidays...)
+	c.AddHolidays(hs...)
+	return nil
+}
@@ -0,0 +1,120 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestCanadianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddCanadianHolidays(c, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	caltest.AssertHolidays(t, c, 2022, map[time.Time]string{
+		date(2022, 1, 1):   "New Year's Day",
+		date(2022, 4, 15):  "Good Friday",
+		date(2022, 5, 23):  "Victoria Day",
+		date(2022, 7, 1):   "Canada Day",
+		date(2022, 9, 5):   "Labour Day",
+		date(2022, 9, 30):  "National Day for Truth and Reconciliation",
+		date(2022, 10, 10): "Thanksgiving",
+		date(2022, 11, 11): "Remembrance Day",
+		date(2022, 12, 25): "Christmas Day",
+		date(2022, 12, 26): "Boxing Day",
+	})
+}
+
+func TestCanadianProvinceHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddCanadianHolidays(c, "QC"); err != nil {
+		t.Fatal(err)
+	}
+
+	caltest.AssertHolidays(t, c, 2022, map[time.Time]string{
+		date(2022, 1, 1):   "New Year's Day",
+		date(2022, 4, 15):  "Good Friday",
+		date(2022, 5, 23):  "National Patriots' Day",
+		date(2022, 6, 24):  "Fête nationale du Québec",
+		date(2022, 7, 1):   "Canada Day",
+		date(2022, 9, 5):   "Labour Day",
+		date(2022, 10, 10): "Thanksgiving",
+		date(2022, 12, 25): "Christmas Day",
+	})
+
+	tests := []struct {
+		province string
+		t        time.Time
+		want     bool
+	}{
+		{"ON", date(2022, 2, 21), true},  // Family Day
+		{"ON", date(2007, 2, 19), false}, // before Family Day
+		{"BC", date(2018, 2, 12), true},  // Family Day, second Monday
+		{"BC", date(2019, 2, 18), true},  // Family Day, third Monday
+		{"BC", date(2019, 2, 11), false},
+		{"BC", date(2022, 8, 1), true},    // British Columbia Day
+		{"MB", date(2022, 2, 21), true},   // Louis Riel Day
+		{"ON", date(2022, 11, 11), false}, // Remembrance Day
+		{"NL", date(2022, 11, 11), true},  // Remembrance Day
+		{"NL", date(2022, 5, 23), false},  // no Victoria Day
+		{"NT", date(2022, 6, 21), true},   // National Indigenous Peoples Day
+		{"NT", date(2022, 9, 30), true},   // National Day for Truth and Reconciliation
+		{"NU", date(2022, 7, 9), true},    // Nunavut Day
+		{"NU", date(2022, 12, 26), true},  // Boxing Day
+		{"YT", date(2022, 8, 15), true},   // Discovery Day
+		{"YT", date(2016, 6, 21), false},  // before National Indigenous Peoples Day
+	}
+
+	for _, test := range tests {
+		c := cal.NewCalendar()
+		if err := cal.AddCanadianHolidays(c, test.province); err != nil {
+			t.Fatal(err)
+		}
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s %s)", got, test.want, test.province, test.t)
+		}
+	}
+
+	if err := cal.AddCanadianHolidays(cal.NewCalendar(), "XX"); err == nil {
+		t.Error("got: nil; want: error for unknown province")
+	}
+}
+
+func TestCanadianWorkdays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddCanadianHolidays(c, ""); err != nil {
+		t.Fatal(err)
+	}
+	qc := cal.NewCalendar()
+	if err := cal.AddCanadianHolidays(qc, "QC"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		c    *cal.Calendar
+		t    time.Time
+		want bool
+	}{
+		{c, date(2021, 12, 31), true},  // Friday before New Year's Day on Saturday
+		{c, date(2022, 1, 3), false},   // New Year's Day on Saturday
+		{c, date(2023, 6, 30), true},   // Friday before Canada Day on Saturday
+		{c, date(2023, 7, 3), false},   // Canada Day on Saturday
+		{c, date(2021, 12, 24), true},  // Friday before Christmas Day on Saturday
+		{c, date(2021, 12, 27), false}, // Christmas Day on Saturday
+		{c, date(2021, 12, 28), false}, // Boxing Day on Sunday
+		{qc, date(2018, 6, 25), false}, // Fête nationale on Sunday
+		{qc, date(2017, 6, 23), true},  // Fête nationale on Saturday is not moved
+		{qc, date(2017, 6, 26), true},
+	}
+
+	for _, test := range tests {
+		got := test.c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
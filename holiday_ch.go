@@ -0,0 +1,94 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Holidays in Switzerland
+//
+// Neither the Confederation nor the cantons give another day off for a
+// holiday that falls on a weekend.
+var (
+	CH_Neujahr     = observedExact(named(US_NewYear, "Neujahr"))
+	CH_Auffahrt    = observedExact(named(catholicAscension, "Auffahrt"))
+	CH_Bundesfeier = observedExact(NewNamedHoliday("Bundesfeier", time.August, 1))
+	CH_Weihnachten = observedExact(named(ECB_ChristmasDay, "Weihnachten"))
+
+	// Holidays in some Swiss cantons
+	CH_Berchtoldstag         = observedExact(NewNamedHoliday("Berchtoldstag", time.January, 2))
+	CH_HeiligeDreiKoenige    = observedExact(named(catholicEpiphany, "Heilige Drei Könige"))
+	CH_Josefstag             = observedExact(NewNamedHoliday("Josefstag", time.March, 19))
+	CH_Karfreitag            = observedExact(named(ECB_GoodFriday, "Karfreitag"))
+	CH_Ostermontag           = observedExact(named(ECB_EasterMonday, "Ostermontag"))
+	CH_TagDerArbeit          = observedExact(named(ECB_LabourDay, "Tag der Arbeit"))
+	CH_Pfingstmontag         = observedExact(named(catholicWhitMonday, "Pfingstmontag"))
+	CH_Fronleichnam          = observedExact(named(catholicCorpusChristi, "Fronleichnam"))
+	CH_PeterUndPaul          = observedExact(NewNamedHoliday("Peter und Paul", time.June, 29))
+	CH_MariaHimmelfahrt      = observedExact(named(catholicAssumption, "Mariä Himmelfahrt"))
+	CH_JeuneGenevois         = observedExact(NewNamedHolidayFunc("Jeûne genevois", calculateJeuneGenevois))
+	CH_Bettagsmontag         = observedExact(NewNamedHolidayFunc("Bettagsmontag", calculateBettagsmontag))
+	CH_Allerheiligen         = observedExact(named(catholicAllSaints, "Allerheiligen"))
+	CH_MariaEmpfaengnis      = observedExact(named(catholicImmaculate, "Mariä Empfängnis"))
+	CH_Stephanstag           = observedExact(named(ECB_ChristmasHoliday, "Stephanstag"))
+	CH_RestaurationGenevoise = observedExact(NewNamedHoliday("Restauration de la République", time.December, 31))
+)
+
+var swissHolidays = []Holiday{
+	CH_Neujahr,
+	CH_Auffahrt,
+	CH_Bundesfeier,
+	CH_Weihnachten,
+}
+
+// swissCantonHolidays holds the holidays of each canton in addition to the
+// federal ones, by canton code.
+var swissCantonHolidays = map[string][]Holiday{
+	"BE": {CH_Berchtoldstag, CH_Karfreitag, CH_Ostermontag, CH_Pfingstmontag, CH_Stephanstag},
+	"BS": {CH_Karfreitag, CH_Ostermontag, CH_TagDerArbeit, CH_Pfingstmontag, CH_Stephanstag},
+	"GE": {CH_Karfreitag, CH_Ostermontag, CH_Pfingstmontag, CH_JeuneGenevois, CH_RestaurationGenevoise},
+	"LU": {
+		CH_Berchtoldstag, CH_Karfreitag, CH_Ostermontag, CH_Pfingstmontag, CH_Fronleichnam,
+		CH_MariaHimmelfahrt, CH_Allerheiligen, CH_MariaEmpfaengnis, CH_Stephanstag,
+	},
+	"TI": {
+		CH_HeiligeDreiKoenige, CH_Josefstag, CH_Ostermontag, CH_TagDerArbeit, CH_Pfingstmontag,
+		CH_Fronleichnam, CH_PeterUndPaul, CH_MariaHimmelfahrt, CH_Allerheiligen,
+		CH_MariaEmpfaengnis, CH_Stephanstag,
+	},
+	"VD": {CH_Berchtoldstag, CH_Karfreitag, CH_Ostermontag, CH_Pfingstmontag, CH_Bettagsmontag},
+	"VS": {CH_Josefstag, CH_Fronleichnam, CH_MariaHimmelfahrt, CH_Allerheiligen, CH_MariaEmpfaengnis},
+	"ZG": {
+		CH_Berchtoldstag, CH_Karfreitag, CH_Ostermontag, CH_Pfingstmontag, CH_Fronleichnam,
+		CH_MariaHimmelfahrt, CH_Allerheiligen, CH_MariaEmpfaengnis, CH_Stephanstag,
+	},
+	"ZH": {CH_Berchtoldstag, CH_Karfreitag, CH_Ostermontag, CH_TagDerArbeit, CH_Pfingstmontag, CH_Stephanstag},
+}
+
+// Jeûne genevois is the Thursday after the first Sunday of September.
+func calculateJeuneGenevois(year int, loc *time.Location) (time.Month, int) {
+	day := WeekdayOnOrAfter(time.Date(year, time.September, 1, 0, 0, 0, 0, loc), time.Sunday).AddDate(0, 0, 4)
+	return day.Month(), day.Day()
+}
+
+// Bettagsmontag is the Monday after the Federal Day of Thanksgiving, the
+// third Sunday of September.
+func calculateBettagsmontag(year int, loc *time.Location) (time.Month, int) {
+	day := WeekdayOnOrAfter(time.Date(year, time.September, 15, 0, 0, 0, 0, loc), time.Sunday).AddDate(0, 0, 1)
+	return day.Month(), day.Day()
+}
+
+// AddSwissHolidays adds all Swiss federal holidays to Calendar, along with
+// those of the given canton (such as "ZH" for Zurich). An empty canton adds
+// the federal holidays only.
+func AddSwissHolidays(c *Calendar, canton string) error {
+	hs, ok := swissCantonHolidays[canton]
+	if !ok && canton != "" {
+		return fmt.Errorf("cal: unknown Swiss canton %q", canton)
+	}
+	c.AddHolidays(swissHolidays...)
+	c.AddHolidays(hs...)
+	return nil
+}
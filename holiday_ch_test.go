@@ -0,0 +1,85 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestSwissHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddSwissHolidays(c, "GE"); err != nil {
+		t.Fatal(err)
+	}
+
+	caltest.AssertHolidays(t, c, 2022, map[time.Time]string{
+		date(2022, 1, 1):   "Neujahr",
+		date(2022, 4, 15):  "Karfreitag",
+		date(2022, 4, 18):  "Ostermontag",
+		date(2022, 5, 26):  "Auffahrt",
+		date(2022, 6, 6):   "Pfingstmontag",
+		date(2022, 8, 1):   "Bundesfeier",
+		date(2022, 9, 8):   "Jeûne genevois",
+		date(2022, 12, 25): "Weihnachten",
+		date(2022, 12, 31): "Restauration de la République",
+	})
+}
+
+func TestSwissCantonHolidays(t *testing.T) {
+	tests := []struct {
+		canton string
+		t      time.Time
+		want   bool
+	}{
+		{"", date(2022, 8, 1), true},     // Bundesfeier
+		{"", date(2022, 1, 2), false},    // Berchtoldstag
+		{"ZH", date(2022, 1, 2), true},   // Berchtoldstag
+		{"ZH", date(2022, 6, 16), false}, // Fronleichnam
+		{"LU", date(2022, 6, 16), true},  // Fronleichnam
+		{"VD", date(2022, 9, 19), true},  // Bettagsmontag
+		{"TI", date(2022, 6, 29), true},  // Peter und Paul
+		{"VS", date(2022, 4, 15), false}, // Karfreitag
+		{"VS", date(2022, 3, 19), true},  // Josefstag
+	}
+
+	for _, test := range tests {
+		c := cal.NewCalendar()
+		if err := cal.AddSwissHolidays(c, test.canton); err != nil {
+			t.Fatal(err)
+		}
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s %s)", got, test.want, test.canton, test.t)
+		}
+	}
+
+	if err := cal.AddSwissHolidays(cal.NewCalendar(), "XX"); err == nil {
+		t.Error("got: nil; want: error for unknown canton")
+	}
+}
+
+func TestSwissWorkdays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddSwissHolidays(c, "GE"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2020, 7, 31), true},  // Friday before Bundesfeier
+		{date(2021, 8, 2), true},   // Monday after Bundesfeier
+		{date(2021, 12, 24), true}, // Friday before Weihnachten
+		{date(2022, 12, 30), true}, // Friday before Restauration de la République
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
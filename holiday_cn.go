@@ -0,0 +1,129 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in China
+//
+// These are the statutory days off. Each year the State Council joins them
+// with weekends into longer breaks, such as the Golden Week in October, and
+// moves the lost work days to weekends; AddChineseHolidays includes these
+// arrangements for the years in chineseArrangements. Holidays are never moved
+// by an ObservedRule.
+var (
+	CN_YuanDan  = observedExact(named(US_NewYear, "元旦"))
+	CN_ChuXi    = observedExact(validFrom(named(NewHolidayRelative(CN_ChunJie, -1), "除夕"), 2025))
+	CN_ChunJie  = observedExact(Holiday{Name: "春节", FuncOK: calculateLunarNewYear})
+	CN_ChunJie2 = observedExact(named(NewHolidayRelative(CN_ChunJie, 1), "春节"))
+	CN_ChunJie3 = observedExact(named(NewHolidayRelative(CN_ChunJie, 2), "春节"))
+	CN_QingMing = observedExact(NewNamedHolidayFunc("清明节", calculateQingming))
+	CN_LaoDong  = observedExact(named(ECB_LabourDay, "劳动节"))
+	CN_LaoDong2 = observedExact(validFrom(NewNamedHoliday("劳动节", time.May, 2), 2025))
+	CN_DuanWu   = observedExact(Holiday{Name: "端午节", FuncOK: calculateDragonBoat})
+	CN_ZhongQiu = observedExact(Holiday{Name: "中秋节", FuncOK: calculateMidAutumn})
+	CN_GuoQing  = observedExact(NewNamedHoliday("国庆节", time.October, 1))
+	CN_GuoQing2 = observedExact(NewNamedHoliday("国庆节", time.October, 2))
+	CN_GuoQing3 = observedExact(NewNamedHoliday("国庆节", time.October, 3))
+)
+
+var chineseHolidays = []Holiday{
+	CN_YuanDan,
+	CN_ChuXi,
+	CN_ChunJie,
+	CN_ChunJie2,
+	CN_ChunJie3,
+	CN_QingMing,
+	CN_LaoDong,
+	CN_LaoDong2,
+	CN_DuanWu,
+	CN_ZhongQiu,
+	CN_GuoQing,
+	CN_GuoQing2,
+	CN_GuoQing3,
+}
+
+// chineseBreak is a period of days off set by the State Council.
+type chineseBreak struct {
+	name     string
+	from, to time.Time
+}
+
+// chineseArrangement holds the breaks and the weekend days worked to make up
+// for them for a year.
+type chineseArrangement struct {
+	breaks  []chineseBreak
+	makeups []time.Time
+}
+
+// chineseArrangements holds the holiday arrangements published by the State
+// Council, by year.
+var chineseArrangements = map[int]chineseArrangement{
+	2023: {
+		breaks: []chineseBreak{
+			{"元旦", ymd(2022, time.December, 31), ymd(2023, time.January, 2)},
+			{"春节", ymd(2023, time.January, 21), ymd(2023, time.January, 27)},
+			{"清明节", ymd(2023, time.April, 5), ymd(2023, time.April, 5)},
+			{"劳动节", ymd(2023, time.April, 29), ymd(2023, time.May, 3)},
+			{"端午节", ymd(2023, time.June, 22), ymd(2023, time.June, 24)},
+			{"中秋节、国庆节", ymd(2023, time.September, 29), ymd(2023, time.October, 6)},
+		},
+		makeups: []time.Time{
+			ymd(2023, time.January, 28), ymd(2023, time.January, 29),
+			ymd(2023, time.April, 23), ymd(2023, time.May, 6),
+			ymd(2023, time.June, 25),
+			ymd(2023, time.October, 7), ymd(2023, time.October, 8),
+		},
+	},
+	2024: {
+		breaks: []chineseBreak{
+			{"元旦", ymd(2023, time.December, 30), ymd(2024, time.January, 1)},
+			{"春节", ymd(2024, time.February, 10), ymd(2024, time.February, 17)},
+			{"清明节", ymd(2024, time.April, 4), ymd(2024, time.April, 6)},
+			{"劳动节", ymd(2024, time.May, 1), ymd(2024, time.May, 5)},
+			{"端午节", ymd(2024, time.June, 8), ymd(2024, time.June, 10)},
+			{"中秋节", ymd(2024, time.September, 15), ymd(2024, time.September, 17)},
+			{"国庆节", ymd(2024, time.October, 1), ymd(2024, time.October, 7)},
+		},
+		makeups: []time.Time{
+			ymd(2024, time.February, 4), ymd(2024, time.February, 18),
+			ymd(2024, time.April, 7),
+			ymd(2024, time.April, 28), ymd(2024, time.May, 11),
+			ymd(2024, time.September, 14),
+			ymd(2024, time.September, 29), ymd(2024, time.October, 12),
+		},
+	},
+	2025: {
+		breaks: []chineseBreak{
+			{"元旦", ymd(2025, time.January, 1), ymd(2025, time.January, 1)},
+			{"春节", ymd(2025, time.January, 28), ymd(2025, time.February, 4)},
+			{"清明节", ymd(2025, time.April, 4), ymd(2025, time.April, 6)},
+			{"劳动节", ymd(2025, time.May, 1), ymd(2025, time.May, 5)},
+			{"端午节", ymd(2025, time.May, 31), ymd(2025, time.June, 2)},
+			{"国庆节、中秋节", ymd(2025, time.October, 1), ymd(2025, time.October, 8)},
+		},
+		makeups: []time.Time{
+			ymd(2025, time.January, 26), ymd(2025, time.February, 8),
+			ymd(2025, time.April, 27),
+			ymd(2025, time.September, 28), ymd(2025, time.October, 11),
+		},
+	},
+}
+
+// AddChineseHolidays adds all Chinese holidays to Calendar, along with the
+// breaks and make up work days of the known holiday arrangements
+func AddChineseHolidays(c *Calendar) {
+	c.AddHolidays(chineseHolidays...)
+	for _, a := range chineseArrangements {
+		for _, b := range a.breaks {
+			for d := b.from; !d.After(b.to); d = d.AddDate(0, 0, 1) {
+				if !c.IsHoliday(d) {
+					c.AddHoliday(observedExact(named(NewHolidayExact(d.Year(), d.Month(), d.Day()), b.name)))
+				}
+			}
+		}
+		for _, d := range a.makeups {
+			c.AddWorkdayOverride(d)
+		}
+	}
+}
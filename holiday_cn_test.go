@@ -0,0 +1,63 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestChineseHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("CN")...)
+
+	caltest.AssertHolidays(t, c, 2026, map[time.Time]string{
+		date(2026, 1, 1):  "元旦",
+		date(2026, 2, 16): "除夕",
+		date(2026, 2, 17): "春节",
+		date(2026, 2, 18): "春节",
+		date(2026, 2, 19): "春节",
+		date(2026, 4, 5):  "清明节",
+		date(2026, 5, 1):  "劳动节",
+		date(2026, 5, 2):  "劳动节",
+		date(2026, 6, 19): "端午节",
+		date(2026, 9, 25): "中秋节",
+		date(2026, 10, 1): "国庆节",
+		date(2026, 10, 2): "国庆节",
+		date(2026, 10, 3): "国庆节",
+	})
+}
+
+func TestChineseArrangements(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddChineseHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 2, 4), true},   // Sunday worked before 春节
+		{date(2024, 2, 9), true},   // 除夕 before 2025
+		{date(2024, 2, 15), false}, // 春节 break
+		{date(2024, 2, 16), false}, // 春节 break
+		{date(2024, 2, 18), true},  // Sunday worked after 春节
+		{date(2024, 2, 19), true},
+		{date(2024, 4, 7), true},    // Sunday worked after 清明节
+		{date(2024, 10, 7), false},  // Golden Week
+		{date(2024, 10, 12), true},  // Saturday worked after Golden Week
+		{date(2025, 1, 28), false},  // 除夕
+		{date(2025, 10, 8), false},  // Golden Week joined with 中秋节
+		{date(2023, 1, 2), false},   // 元旦 break
+		{date(2023, 9, 29), false},  // 中秋节
+		{date(2023, 10, 7), true},   // Saturday worked
+		{date(2024, 11, 30), false}, // ordinary Saturday
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in the Czech Republic
+//
+// A holiday that falls on a weekend is not replaced by a day off.
+var (
+	CZ_NovyRok            = observedExact(named(US_NewYear, "Den obnovy samostatného českého státu"))
+	CZ_VelkyPatek         = observedExact(validFrom(named(ECB_GoodFriday, "Velký pátek"), 2016))
+	CZ_VelikonocniPondeli = observedExact(named(ECB_EasterMonday, "Velikonoční pondělí"))
+	CZ_SvatekPrace        = observedExact(named(ECB_LabourDay, "Svátek práce"))
+	CZ_DenVitezstvi       = observedExact(NewNamedHoliday("Den vítězství", time.May, 8))
+	CZ_CyrilAMetodej      = observedExact(NewNamedHoliday("Den slovanských věrozvěstů Cyrila a Metoděje", time.July, 5))
+	CZ_JanHus             = observedExact(NewNamedHoliday("Den upálení mistra Jana Husa", time.July, 6))
+	CZ_DenCeskeStatnosti  = observedExact(NewNamedHoliday("Den české státnosti", time.September, 28))
+	CZ_DenVznikuStatu     = observedExact(NewNamedHoliday("Den vzniku samostatného československého státu", time.October, 28))
+	CZ_DenBojeZaSvobodu   = observedExact(NewNamedHoliday("Den boje za svobodu a demokracii", time.November, 17))
+	CZ_StedryDen          = observedExact(NewNamedHoliday("Štědrý den", time.December, 24))
+	CZ_PrvniSvatekVanocni = observedExact(named(ECB_ChristmasDay, "1. svátek vánoční"))
+	CZ_DruhySvatekVanocni = observedExact(named(ECB_ChristmasHoliday, "2. svátek vánoční"))
+)
+
+var czechHolidays = []Holiday{
+	CZ_NovyRok,
+	CZ_VelkyPatek,
+	CZ_VelikonocniPondeli,
+	CZ_SvatekPrace,
+	CZ_DenVitezstvi,
+	CZ_CyrilAMetodej,
+	CZ_JanHus,
+	CZ_DenCeskeStatnosti,
+	CZ_DenVznikuStatu,
+	CZ_DenBojeZaSvobodu,
+	CZ_StedryDen,
+	CZ_PrvniSvatekVanocni,
+	CZ_DruhySvatekVanocni,
+}
+
+// AddCzechHolidays adds all Czech holidays to Calendar
+func AddCzechHolidays(c *Calendar) {
+	c.AddHolidays(czechHolidays...)
+}
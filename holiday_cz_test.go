@@ -0,0 +1,55 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestCzechHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddCzechHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "Den obnovy samostatného českého státu",
+		date(2023, 4, 7):   "Velký pátek",
+		date(2023, 4, 10):  "Velikonoční pondělí",
+		date(2023, 5, 1):   "Svátek práce",
+		date(2023, 5, 8):   "Den vítězství",
+		date(2023, 7, 5):   "Den slovanských věrozvěstů Cyrila a Metoděje",
+		date(2023, 7, 6):   "Den upálení mistra Jana Husa",
+		date(2023, 9, 28):  "Den české státnosti",
+		date(2023, 10, 28): "Den vzniku samostatného československého státu",
+		date(2023, 11, 17): "Den boje za svobodu a demokracii",
+		date(2023, 12, 24): "Štědrý den",
+		date(2023, 12, 25): "1. svátek vánoční",
+		date(2023, 12, 26): "2. svátek vánoční",
+	})
+
+	if c.IsHoliday(date(2015, 4, 3)) {
+		t.Errorf("Did not expect Velký pátek before 2016")
+	}
+}
+
+func TestCzechWorkdays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddCzechHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2019, 9, 27), true},  // Friday before Den české státnosti
+		{date(2018, 10, 29), true}, // Monday after Den vzniku samostatného československého státu
+		{date(2021, 12, 27), true}, // Monday after 2. svátek vánoční
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
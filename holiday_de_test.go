@@ -0,0 +1,43 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+}
+
+func TestGermanHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.Observed = cal.ObservedExact
+	cal.AddGermanHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2016, map[time.Time]string{
+		date(2016, 1, 1):   "Neujahr",
+		date(2016, 3, 25):  "Karfreitag",
+		date(2016, 3, 28):  "Ostermontag",
+		date(2016, 5, 1):   "Tag der Arbeit",
+		date(2016, 5, 5):   "Christi Himmelfahrt",
+		date(2016, 5, 16):  "Pfingstmontag",
+		date(2016, 10, 3):  "Tag der Deutschen Einheit",
+		date(2016, 12, 25): "Erster Weihnachtstag",
+		date(2016, 12, 26): "Zweiter Weihnachtstag",
+	})
+
+	caltest.AssertHolidays(t, c, 2000, map[time.Time]string{
+		date(2000, 1, 1):   "Neujahr",
+		date(2000, 4, 21):  "Karfreitag",
+		date(2000, 4, 24):  "Ostermontag",
+		date(2000, 5, 1):   "Tag der Arbeit",
+		date(2000, 6, 1):   "Christi Himmelfahrt",
+		date(2000, 6, 12):  "Pfingstmontag",
+		date(2000, 10, 3):  "Tag der Deutschen Einheit",
+		date(2000, 12, 25): "Erster Weihnachtstag",
+		date(2000, 12, 26): "Zweiter Weihnachtstag",
+	})
+}
@@ -0,0 +1,45 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Denmark
+//
+// Påskedag and Pinsedag are Sundays, and like the other holidays they are not
+// moved to a work day.
+var (
+	DK_Nytaarsdag       = observedExact(named(US_NewYear, "Nytårsdag"))
+	DK_Skaertorsdag     = observedExact(named(NewHolidayEasterOffset(-3), "Skærtorsdag"))
+	DK_Langfredag       = observedExact(named(ECB_GoodFriday, "Langfredag"))
+	DK_Paaskedag        = observedExact(named(NewHolidayEasterOffset(0), "Påskedag"))
+	DK_AndenPaaskedag   = observedExact(named(ECB_EasterMonday, "2. påskedag"))
+	DK_StoreBededag     = observedExact(Holiday{Name: "Store bededag", Easter: true, Offset: 26, ValidTo: 2023})
+	DK_KristiHimmelfart = observedExact(named(catholicAscension, "Kristi himmelfartsdag"))
+	DK_Pinsedag         = observedExact(named(NewHolidayEasterOffset(49), "Pinsedag"))
+	DK_AndenPinsedag    = observedExact(named(catholicWhitMonday, "2. pinsedag"))
+	DK_Grundlovsdag     = observedExact(NewNamedHoliday("Grundlovsdag", time.June, 5))
+	DK_Juledag          = observedExact(named(ECB_ChristmasDay, "Juledag"))
+	DK_AndenJuledag     = observedExact(named(ECB_ChristmasHoliday, "2. juledag"))
+)
+
+var danishHolidays = []Holiday{
+	DK_Nytaarsdag,
+	DK_Skaertorsdag,
+	DK_Langfredag,
+	DK_Paaskedag,
+	DK_AndenPaaskedag,
+	DK_StoreBededag,
+	DK_KristiHimmelfart,
+	DK_Pinsedag,
+	DK_AndenPinsedag,
+	DK_Grundlovsdag,
+	DK_Juledag,
+	DK_AndenJuledag,
+}
+
+// AddDanishHolidays adds all Danish holidays to Calendar. Store bededag is
+// only included until 2023, the last year before it was abolished.
+func AddDanishHolidays(c *Calendar) {
+	c.AddHolidays(danishHolidays...)
+}
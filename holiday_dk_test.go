@@ -0,0 +1,50 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestDanishHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddDanishHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "Nytårsdag",
+		date(2023, 4, 6):   "Skærtorsdag",
+		date(2023, 4, 7):   "Langfredag",
+		date(2023, 4, 9):   "Påskedag",
+		date(2023, 4, 10):  "2. påskedag",
+		date(2023, 5, 5):   "Store bededag",
+		date(2023, 5, 18):  "Kristi himmelfartsdag",
+		date(2023, 5, 28):  "Pinsedag",
+		date(2023, 5, 29):  "2. pinsedag",
+		date(2023, 6, 5):   "Grundlovsdag",
+		date(2023, 12, 25): "Juledag",
+		date(2023, 12, 26): "2. juledag",
+	})
+
+	// Store bededag was abolished from 2024
+	if c.IsHoliday(date(2024, 4, 26)) {
+		t.Errorf("Did not expect Store bededag in 2024")
+	}
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2020, 4, 14), true}, // Tuesday after Påskedag and 2. påskedag
+		{date(2020, 6, 2), true},  // Tuesday after Pinsedag and 2. pinsedag
+		{date(2021, 6, 4), true},  // Friday before Grundlovsdag
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Holidays in Spain
+//
+// The communities may move a holiday that falls on a Sunday to the Monday
+// when they publish their calendar for the year. Those transfers are not
+// modelled, so every holiday is kept on its own day.
+var (
+	ES_AnoNuevo             = observedExact(named(US_NewYear, "Año Nuevo"))
+	ES_Epifania             = observedExact(named(catholicEpiphany, "Epifanía del Señor"))
+	ES_ViernesSanto         = observedExact(named(ECB_GoodFriday, "Viernes Santo"))
+	ES_FiestaDelTrabajo     = observedExact(named(ECB_LabourDay, "Fiesta del Trabajo"))
+	ES_Asuncion             = observedExact(named(catholicAssumption, "Asunción de la Virgen"))
+	ES_FiestaNacional       = observedExact(NewNamedHoliday("Fiesta Nacional de España", time.October, 12))
+	ES_TodosLosSantos       = observedExact(named(catholicAllSaints, "Todos los Santos"))
+	ES_DiaDeLaConstitucion  = observedExact(NewNamedHoliday("Día de la Constitución", time.December, 6))
+	ES_InmaculadaConcepcion = observedExact(named(catholicImmaculate, "Inmaculada Concepción"))
+	ES_Navidad              = observedExact(named(ECB_ChristmasDay, "Natividad del Señor"))
+
+	// Holidays in some autonomous communities
+	ES_JuevesSanto      = observedExact(named(NewHolidayEasterOffset(-3), "Jueves Santo"))
+	ES_LunesDePascua    = observedExact(named(ECB_EasterMonday, "Lunes de Pascua"))
+	ES_DiaDeAndalucia   = observedExact(NewNamedHoliday("Día de Andalucía", time.February, 28))
+	ES_DiaDeLaComunidad = observedExact(NewNamedHoliday("Día de la Comunidad de Madrid", time.May, 2))
+	ES_LetrasGalegas    = observedExact(NewNamedHoliday("Día das Letras Galegas", time.May, 17))
+	ES_SantJoan         = observedExact(NewNamedHoliday("Sant Joan", time.June, 24))
+	ES_Santiago         = observedExact(NewNamedHoliday("Santiago Apóstol", time.July, 25))
+	ES_Diada            = observedExact(NewNamedHoliday("Diada Nacional de Catalunya", time.September, 11))
+	ES_SantEsteve       = observedExact(named(ECB_ChristmasHoliday, "Sant Esteve"))
+
+	// Local holidays of some cities
+	ES_SanIsidro = observedExact(NewNamedHoliday("San Isidro", time.May, 15))
+	ES_LaMerce   = observedExact(NewNamedHoliday("La Mercè", time.September, 24))
+)
+
+var spanishHolidays = []Holiday{
+	ES_AnoNuevo,
+	ES_Epifania,
+	ES_ViernesSanto,
+	ES_FiestaDelTrabajo,
+	ES_Asuncion,
+	ES_FiestaNacional,
+	ES_TodosLosSantos,
+	ES_DiaDeLaConstitucion,
+	ES_InmaculadaConcepcion,
+	ES_Navidad,
+}
+
+// spanishRegionHolidays holds the holidays of each autonomous community in
+// addition to the national ones, by region code. Local holidays, such as San
+// Isidro in Madrid and La Mercè in Barcelona, are not included.
+var spanishRegionHolidays = map[string][]Holiday{
+	"AN": {ES_DiaDeAndalucia, ES_JuevesSanto},
+	"CT": {ES_LunesDePascua, ES_SantJoan, ES_Diada, ES_SantEsteve},
+	"GA": {ES_JuevesSanto, ES_LetrasGalegas, named(ES_Santiago, "Día Nacional de Galicia")},
+	"MD": {ES_JuevesSanto, ES_DiaDeLaComunidad},
+	"PV": {ES_JuevesSanto, ES_LunesDePascua, ES_Santiago},
+}
+
+// AddSpanishHolidays adds all Spanish national holidays to Calendar, along
+// with those of the given autonomous community (such as "CT" for Catalonia).
+// An empty region adds the national holidays only.
+func AddSpanishHolidays(c *Calendar, region string) error {
+	hs, ok := spanishRegionHolidays[region]
+	if !ok && region != "" {
+		return fmt.Errorf("cal: unknown Spanish region %q", region)
+	}
+	c.AddHolidays(spanishHolidays...)
+	c.AddHolidays(hs...)
+	return nil
+}
@@ -0,0 +1,87 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestSpanishHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddSpanishHolidays(c, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	caltest.AssertHolidays(t, c, 2019, map[time.Time]string{
+		date(2019, 1, 1):   "Año Nuevo",
+		date(2019, 1, 6):   "Epifanía del Señor",
+		date(2019, 4, 19):  "Viernes Santo",
+		date(2019, 5, 1):   "Fiesta del Trabajo",
+		date(2019, 8, 15):  "Asunción de la Virgen",
+		date(2019, 10, 12): "Fiesta Nacional de España",
+		date(2019, 11, 1):  "Todos los Santos",
+		date(2019, 12, 6):  "Día de la Constitución",
+		date(2019, 12, 8):  "Inmaculada Concepción",
+		date(2019, 12, 25): "Natividad del Señor",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2019, 10, 11), true}, // Friday before Fiesta Nacional de España
+		{date(2020, 8, 14), true},  // Friday before Asunción de la Virgen
+		{date(2021, 4, 30), true},  // Friday before Fiesta del Trabajo
+		{date(2021, 12, 31), true}, // Friday before Año Nuevo
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestSpanishRegionHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddSpanishHolidays(c, "CT"); err != nil {
+		t.Fatal(err)
+	}
+
+	caltest.AssertHolidays(t, c, 2019, map[time.Time]string{
+		date(2019, 1, 1):   "Año Nuevo",
+		date(2019, 1, 6):   "Epifanía del Señor",
+		date(2019, 4, 19):  "Viernes Santo",
+		date(2019, 4, 22):  "Lunes de Pascua",
+		date(2019, 5, 1):   "Fiesta del Trabajo",
+		date(2019, 6, 24):  "Sant Joan",
+		date(2019, 8, 15):  "Asunción de la Virgen",
+		date(2019, 9, 11):  "Diada Nacional de Catalunya",
+		date(2019, 10, 12): "Fiesta Nacional de España",
+		date(2019, 11, 1):  "Todos los Santos",
+		date(2019, 12, 6):  "Día de la Constitución",
+		date(2019, 12, 8):  "Inmaculada Concepción",
+		date(2019, 12, 25): "Natividad del Señor",
+		date(2019, 12, 26): "Sant Esteve",
+	})
+
+	c = cal.NewCalendar()
+	if err := cal.AddSpanishHolidays(c, "MD"); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range []time.Time{date(2019, 4, 18), date(2019, 5, 2)} {
+		if !c.IsHoliday(d) {
+			t.Errorf("got: false; want: true (%s)", d)
+		}
+	}
+	if c.IsHoliday(date(2019, 5, 15)) {
+		t.Errorf("got: true; want: false for San Isidro (%s)", date(2019, 5, 15))
+	}
+
+	if err := cal.AddSpanishHolidays(cal.NewCalendar(), "XX"); err == nil {
+		t.Error("got: nil; want: error for unknown region")
+	}
+}
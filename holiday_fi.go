@@ -0,0 +1,50 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Finland
+//
+// Juhannuspäivä and Pyhäinpäivä always fall on a Saturday. A holiday on a
+// weekend gives no day off in lieu.
+var (
+	FI_Uudenvuodenpaiva    = observedExact(named(US_NewYear, "Uudenvuodenpäivä"))
+	FI_Loppiainen          = observedExact(named(catholicEpiphany, "Loppiainen"))
+	FI_Pitkaperjantai      = observedExact(named(ECB_GoodFriday, "Pitkäperjantai"))
+	FI_Paasiaispaiva       = observedExact(named(NewHolidayEasterOffset(0), "Pääsiäispäivä"))
+	FI_ToinenPaasiaispaiva = observedExact(named(ECB_EasterMonday, "Toinen pääsiäispäivä"))
+	FI_Vappu               = observedExact(named(ECB_LabourDay, "Vappu"))
+	FI_Helatorstai         = observedExact(named(catholicAscension, "Helatorstai"))
+	FI_Helluntaipaiva      = observedExact(named(NewHolidayEasterOffset(49), "Helluntaipäivä"))
+	FI_Juhannusaatto       = observedExact(named(NewHolidayWeekdayInRange(time.Friday, time.June, 19, time.June, 25), "Juhannusaatto"))
+	FI_Juhannuspaiva       = observedExact(named(NewHolidayWeekdayInRange(time.Saturday, time.June, 20, time.June, 26), "Juhannuspäivä"))
+	FI_Pyhainpaiva         = observedExact(named(NewHolidayWeekdayInRange(time.Saturday, time.October, 31, time.November, 6), "Pyhäinpäivä"))
+	FI_Itsenaisyyspaiva    = observedExact(NewNamedHoliday("Itsenäisyyspäivä", time.December, 6))
+	FI_Jouluaatto          = observedExact(NewNamedHoliday("Jouluaatto", time.December, 24))
+	FI_Joulupaiva          = observedExact(named(ECB_ChristmasDay, "Joulupäivä"))
+	FI_Tapaninpaiva        = observedExact(named(ECB_ChristmasHoliday, "Tapaninpäivä"))
+)
+
+var finnishHolidays = []Holiday{
+	FI_Uudenvuodenpaiva,
+	FI_Loppiainen,
+	FI_Pitkaperjantai,
+	FI_Paasiaispaiva,
+	FI_ToinenPaasiaispaiva,
+	FI_Vappu,
+	FI_Helatorstai,
+	FI_Helluntaipaiva,
+	FI_Juhannusaatto,
+	FI_Juhannuspaiva,
+	FI_Pyhainpaiva,
+	FI_Itsenaisyyspaiva,
+	FI_Jouluaatto,
+	FI_Joulupaiva,
+	FI_Tapaninpaiva,
+}
+
+// AddFinnishHolidays adds all Finnish holidays to Calendar
+func AddFinnishHolidays(c *Calendar) {
+	c.AddHolidays(finnishHolidays...)
+}
@@ -0,0 +1,51 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestFinnishHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddFinnishHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 1, 1):   "Uudenvuodenpäivä",
+		date(2024, 1, 6):   "Loppiainen",
+		date(2024, 3, 29):  "Pitkäperjantai",
+		date(2024, 3, 31):  "Pääsiäispäivä",
+		date(2024, 4, 1):   "Toinen pääsiäispäivä",
+		date(2024, 5, 1):   "Vappu",
+		date(2024, 5, 9):   "Helatorstai",
+		date(2024, 5, 19):  "Helluntaipäivä",
+		date(2024, 6, 21):  "Juhannusaatto",
+		date(2024, 6, 22):  "Juhannuspäivä",
+		date(2024, 11, 2):  "Pyhäinpäivä",
+		date(2024, 12, 6):  "Itsenäisyyspäivä",
+		date(2024, 12, 24): "Jouluaatto",
+		date(2024, 12, 25): "Joulupäivä",
+		date(2024, 12, 26): "Tapaninpäivä",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 6, 20), true}, // Thursday before Juhannusaatto
+		{date(2024, 6, 21), false},
+		{date(2024, 11, 1), true}, // Friday before Pyhäinpäivä
+		{date(2024, 4, 2), true},  // Tuesday after Easter
+		{date(2024, 5, 20), true}, // Monday after Helluntaipäivä
+		{date(2025, 12, 5), true}, // Friday before Itsenäisyyspäivä
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
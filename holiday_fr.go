@@ -0,0 +1,41 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in France
+//
+// A holiday falling on a Saturday or Sunday is not made up on another day.
+var (
+	FR_JourDeLAn        = observedExact(named(US_NewYear, "Jour de l'an"))
+	FR_LundiDePaques    = observedExact(named(ECB_EasterMonday, "Lundi de Pâques"))
+	FR_FeteDuTravail    = observedExact(named(ECB_LabourDay, "Fête du Travail"))
+	FR_Victoire1945     = observedExact(NewNamedHoliday("Victoire 1945", time.May, 8))
+	FR_Ascension        = observedExact(named(catholicAscension, "Ascension"))
+	FR_LundiDePentecote = observedExact(named(catholicWhitMonday, "Lundi de Pentecôte"))
+	FR_FeteNationale    = observedExact(NewNamedHoliday("Fête Nationale", time.July, 14))
+	FR_Assomption       = observedExact(named(catholicAssumption, "Assomption"))
+	FR_Toussaint        = observedExact(named(catholicAllSaints, "Toussaint"))
+	FR_Armistice        = observedExact(NewNamedHoliday("Armistice 1918", time.November, 11))
+	FR_Noel             = observedExact(named(ECB_ChristmasDay, "Noël"))
+)
+
+var frenchHolidays = []Holiday{
+	FR_JourDeLAn,
+	FR_LundiDePaques,
+	FR_FeteDuTravail,
+	FR_Victoire1945,
+	FR_Ascension,
+	FR_LundiDePentecote,
+	FR_FeteNationale,
+	FR_Assomption,
+	FR_Toussaint,
+	FR_Armistice,
+	FR_Noel,
+}
+
+// AddFrenchHolidays adds all French holidays to Calendar
+func AddFrenchHolidays(c *Calendar) {
+	c.AddHolidays(frenchHolidays...)
+}
@@ -0,0 +1,50 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestFrenchHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddFrenchHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2019, map[time.Time]string{
+		date(2019, 1, 1):   "Jour de l'an",
+		date(2019, 4, 22):  "Lundi de Pâques",
+		date(2019, 5, 1):   "Fête du Travail",
+		date(2019, 5, 8):   "Victoire 1945",
+		date(2019, 5, 30):  "Ascension",
+		date(2019, 6, 10):  "Lundi de Pentecôte",
+		date(2019, 7, 14):  "Fête Nationale",
+		date(2019, 8, 15):  "Assomption",
+		date(2019, 11, 1):  "Toussaint",
+		date(2019, 11, 11): "Armistice 1918",
+		date(2019, 12, 25): "Noël",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2018, 7, 13), true},  // Friday before Fête Nationale
+		{date(2019, 7, 15), true},  // Monday after Fête Nationale
+		{date(2020, 8, 14), true},  // Friday before Assomption
+		{date(2020, 11, 2), true},  // Monday after Toussaint
+		{date(2022, 12, 26), true}, // Monday after Noël
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	if name, _ := c.HolidayName(date(2019, 7, 14)); name != "Fête Nationale" {
+		t.Errorf("got: %q; want: %q", name, "Fête Nationale")
+	}
+}
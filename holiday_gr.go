@@ -0,0 +1,44 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Greece
+//
+// The movable holidays follow the Orthodox Easter whatever the EasterMethod of
+// the calendar.
+var (
+	GR_Protochronia     = named(US_NewYear, "Πρωτοχρονιά")
+	GR_Theofania        = named(catholicEpiphany, "Θεοφάνεια")
+	GR_KatharaDeftera   = named(NewHolidayOrthodoxEasterOffset(-48), "Καθαρά Δευτέρα")
+	GR_Evangelismos     = NewNamedHoliday("Ευαγγελισμός της Θεοτόκου", time.March, 25)
+	GR_MegaliParaskevi  = named(NewHolidayOrthodoxEasterOffset(-2), "Μεγάλη Παρασκευή")
+	GR_DefteraPascha    = named(NewHolidayOrthodoxEasterOffset(1), "Δευτέρα του Πάσχα")
+	GR_Protomagia       = named(ECB_LabourDay, "Πρωτομαγιά")
+	GR_AgiouPnevmatos   = named(NewHolidayOrthodoxEasterOffset(50), "Αγίου Πνεύματος")
+	GR_KoimisiTheotokou = named(catholicAssumption, "Κοίμηση της Θεοτόκου")
+	GR_EpeteiosOchi     = NewNamedHoliday("Επέτειος του Όχι", time.October, 28)
+	GR_Christougenna    = named(ECB_ChristmasDay, "Χριστούγεννα")
+	GR_SynaxiTheotokou  = named(ECB_ChristmasHoliday, "Σύναξη της Θεοτόκου")
+)
+
+var greekHolidays = []Holiday{
+	GR_Protochronia,
+	GR_Theofania,
+	GR_KatharaDeftera,
+	GR_Evangelismos,
+	GR_MegaliParaskevi,
+	GR_DefteraPascha,
+	GR_Protomagia,
+	GR_AgiouPnevmatos,
+	GR_KoimisiTheotokou,
+	GR_EpeteiosOchi,
+	GR_Christougenna,
+	GR_SynaxiTheotokou,
+}
+
+// AddGreekHolidays adds all Greek holidays to Calendar
+func AddGreekHolidays(c *Calendar) {
+	c.AddHolidays(greekHolidays...)
+}
@@ -0,0 +1,45 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestGreekHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("GR")...)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 1, 1):   "Πρωτοχρονιά",
+		date(2024, 1, 6):   "Θεοφάνεια",
+		date(2024, 3, 18):  "Καθαρά Δευτέρα",
+		date(2024, 3, 25):  "Ευαγγελισμός της Θεοτόκου",
+		date(2024, 5, 1):   "Πρωτομαγιά",
+		date(2024, 5, 3):   "Μεγάλη Παρασκευή",
+		date(2024, 5, 6):   "Δευτέρα του Πάσχα",
+		date(2024, 6, 24):  "Αγίου Πνεύματος",
+		date(2024, 8, 15):  "Κοίμηση της Θεοτόκου",
+		date(2024, 10, 28): "Επέτειος του Όχι",
+		date(2024, 12, 25): "Χριστούγεννα",
+		date(2024, 12, 26): "Σύναξη της Θεοτόκου",
+	})
+
+	// the same Easter in both churches
+	caltest.AssertHolidays(t, c, 2025, map[time.Time]string{
+		date(2025, 1, 1):   "Πρωτοχρονιά",
+		date(2025, 1, 6):   "Θεοφάνεια",
+		date(2025, 3, 3):   "Καθαρά Δευτέρα",
+		date(2025, 3, 25):  "Ευαγγελισμός της Θεοτόκου",
+		date(2025, 4, 18):  "Μεγάλη Παρασκευή",
+		date(2025, 4, 21):  "Δευτέρα του Πάσχα",
+		date(2025, 5, 1):   "Πρωτομαγιά",
+		date(2025, 6, 9):   "Αγίου Πνεύματος",
+		date(2025, 8, 15):  "Κοίμηση της Θεοτόκου",
+		date(2025, 10, 28): "Επέτειος του Όχι",
+		date(2025, 12, 25): "Χριστούγεννα",
+		date(2025, 12, 26): "Σύναξη της Θεοτόκου",
+	})
+}
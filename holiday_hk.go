@@ -0,0 +1,79 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Hong Kong
+//
+// These are the general holidays of the General Holidays Ordinance. One that
+// falls on a Sunday is observed on the next day that is not a holiday, so a
+// Sunday among the Lunar New Year days makes the fourth day a holiday.
+var (
+	HK_NewYear           = hkHoliday(named(US_NewYear, "The first day of January"))
+	HK_LunarNewYear      = hkHoliday(Holiday{Name: "Lunar New Year's Day", FuncOK: calculateLunarNewYear})
+	HK_LunarNewYear2     = hkHoliday(named(NewHolidayRelative(HK_LunarNewYear, 1), "The second day of Lunar New Year"))
+	HK_LunarNewYear3     = hkHoliday(named(NewHolidayRelative(HK_LunarNewYear, 2), "The third day of Lunar New Year"))
+	HK_ChingMing         = Holiday{Name: "Ching Ming Festival", Func: calculateQingming, ObservedFunc: observedChingMing}
+	HK_GoodFriday        = hkHoliday(ECB_GoodFriday)
+	HK_GoodFridayAfter   = hkHoliday(named(NewHolidayEasterOffset(-1), "The day following Good Friday"))
+	HK_EasterMonday      = hkHoliday(ECB_EasterMonday)
+	HK_LabourDay         = hkHoliday(named(ECB_LabourDay, "Labour Day"))
+	HK_BuddhasBirthday   = hkHoliday(Holiday{Name: "The Birthday of the Buddha", FuncOK: calculateBuddhasBirthday})
+	HK_TuenNg            = hkHoliday(Holiday{Name: "Tuen Ng Festival", FuncOK: calculateDragonBoat})
+	HK_EstablishmentDay  = hkHoliday(validFrom(NewNamedHoliday("Hong Kong Special Administrative Region Establishment Day", time.July, 1), 1997))
+	HK_MidAutumnAfter    = hkHoliday(named(NewHolidayRelative(Holiday{FuncOK: calculateMidAutumn}, 1), "The day following the Chinese Mid-Autumn Festival"))
+	HK_NationalDay       = hkHoliday(validFrom(NewNamedHoliday("National Day", time.October, 1), 1997))
+	HK_ChungYeung        = hkHoliday(Holiday{Name: "Chung Yeung Festival", FuncOK: calculateDoubleNinth})
+	HK_ChristmasDay      = hkHoliday(ECB_ChristmasDay)
+	HK_ChristmasDayAfter = hkHoliday(NewNamedHolidayFunc("The first weekday after Christmas Day", calculateFirstWeekdayAfterChristmas))
+)
+
+var hongKongHolidays = []Holiday{
+	HK_NewYear,
+	HK_LunarNewYear,
+	HK_LunarNewYear2,
+	HK_LunarNewYear3,
+	HK_ChingMing,
+	HK_GoodFriday,
+	HK_GoodFridayAfter,
+	HK_EasterMonday,
+	HK_LabourDay,
+	HK_BuddhasBirthday,
+	HK_TuenNg,
+	HK_EstablishmentDay,
+	HK_MidAutumnAfter,
+	HK_NationalDay,
+	HK_ChungYeung,
+	HK_ChristmasDay,
+	HK_ChristmasDayAfter,
+}
+
+// hkHoliday returns a copy of the holiday that is moved from a Sunday to the
+// next day that is not a holiday.
+func hkHoliday(h Holiday) Holiday {
+	return NewHolidayObserved(h, ObservedSundayToMonday)
+}
+
+// observedChingMing moves the Ching Ming Festival from a Sunday, or from
+// Easter Monday, to the next day that is not a holiday.
+func observedChingMing(date time.Time, c *Calendar) time.Time {
+	if date.Weekday() != time.Sunday && len(c.HolidaysOn(date)) < 2 {
+		return date
+	}
+	return c.nextFreeDay(date)
+}
+
+// The first weekday after Christmas Day is December 26th, or the 27th when the
+// 26th falls on a Sunday.
+func calculateFirstWeekdayAfterChristmas(year int, loc *time.Location) (time.Month, int) {
+	if time.Date(year, time.December, 26, 0, 0, 0, 0, loc).Weekday() == time.Sunday {
+		return time.December, 27
+	}
+	return time.December, 26
+}
+
+// AddHongKongHolidays adds all Hong Kong general holidays to Calendar
+func AddHongKongHolidays(c *Calendar) {
+	c.AddHolidays(hongKongHolidays...)
+}
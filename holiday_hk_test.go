@@ -0,0 +1,62 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestHongKongHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("HK")...)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "The first day of January",
+		date(2023, 1, 22):  "Lunar New Year's Day",
+		date(2023, 1, 23):  "The second day of Lunar New Year",
+		date(2023, 1, 24):  "The third day of Lunar New Year",
+		date(2023, 4, 5):   "Ching Ming Festival",
+		date(2023, 4, 7):   "Good Friday",
+		date(2023, 4, 8):   "The day following Good Friday",
+		date(2023, 4, 10):  "Easter Monday",
+		date(2023, 5, 1):   "Labour Day",
+		date(2023, 5, 26):  "The Birthday of the Buddha",
+		date(2023, 6, 22):  "Tuen Ng Festival",
+		date(2023, 7, 1):   "Hong Kong Special Administrative Region Establishment Day",
+		date(2023, 9, 30):  "The day following the Chinese Mid-Autumn Festival",
+		date(2023, 10, 1):  "National Day",
+		date(2023, 10, 23): "Chung Yeung Festival",
+		date(2023, 12, 25): "Christmas Day",
+		date(2023, 12, 26): "The first weekday after Christmas Day",
+	})
+}
+
+func TestHongKongSubstitutes(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddHongKongHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2023, 1, 2), false},  // New Year on Sunday
+		{date(2023, 1, 25), false}, // Lunar New Year's Day on Sunday
+		{date(2023, 1, 26), true},
+		{date(2023, 10, 2), false},  // National Day on Sunday
+		{date(2021, 4, 6), false},   // Ching Ming on Easter Sunday
+		{date(2026, 4, 7), false},   // Ching Ming on Easter Sunday
+		{date(2022, 9, 12), false},  // day after Mid-Autumn on Sunday
+		{date(2022, 12, 26), false}, // Christmas on Sunday
+		{date(2022, 12, 27), false},
+		{date(2022, 12, 28), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
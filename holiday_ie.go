@@ -0,0 +1,50 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Ireland
+//
+// When a holiday on a fixed date falls on a Saturday or Sunday, the next
+// weekday that is not itself a holiday is taken off instead.
+var (
+	IE_NewYear        = NewHolidayObserved(named(US_NewYear, "New Year's Day"), ObservedMonday)
+	IE_StBrigidsDay   = Holiday{Name: "St. Brigid's Day", Func: calculateStBrigidsDay, ValidFrom: 2023}
+	IE_StPatricksDay  = NewHolidayObserved(NewNamedHoliday("St. Patrick's Day", time.March, 17), ObservedMonday)
+	IE_EasterMonday   = named(ECB_EasterMonday, "Easter Monday")
+	IE_MayDay         = NewNamedHolidayFloat("May Bank Holiday", time.May, time.Monday, 1)
+	IE_JuneHoliday    = NewNamedHolidayFloat("June Bank Holiday", time.June, time.Monday, 1)
+	IE_AugustHoliday  = NewNamedHolidayFloat("August Bank Holiday", time.August, time.Monday, 1)
+	IE_OctoberHoliday = NewNamedHolidayFloat("October Bank Holiday", time.October, time.Monday, -1)
+	IE_ChristmasDay   = NewHolidayObserved(named(ECB_ChristmasDay, "Christmas Day"), ObservedMonday)
+	IE_StStephensDay  = NewHolidayObserved(named(ECB_ChristmasHoliday, "St. Stephen's Day"), ObservedMonday)
+)
+
+var irishHolidays = []Holiday{
+	IE_NewYear,
+	IE_StBrigidsDay,
+	IE_StPatricksDay,
+	IE_EasterMonday,
+	IE_MayDay,
+	IE_JuneHoliday,
+	IE_AugustHoliday,
+	IE_OctoberHoliday,
+	IE_ChristmasDay,
+	IE_StStephensDay,
+}
+
+// St. Brigid's Day is the first Monday of February, or February 1st when it
+// falls on a Friday.
+func calculateStBrigidsDay(year int, loc *time.Location) (time.Month, int) {
+	day := time.Date(year, time.February, 1, 0, 0, 0, 0, loc)
+	if day.Weekday() != time.Friday {
+		day = WeekdayOnOrAfter(day, time.Monday)
+	}
+	return day.Month(), day.Day()
+}
+
+// AddIrishHolidays adds all Irish holidays to Calendar
+func AddIrishHolidays(c *Calendar) {
+	c.AddHolidays(irishHolidays...)
+}
@@ -0,0 +1,62 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestIrishHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddIrishHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "New Year's Day",
+		date(2023, 2, 6):   "St. Brigid's Day",
+		date(2023, 3, 17):  "St. Patrick's Day",
+		date(2023, 4, 10):  "Easter Monday",
+		date(2023, 5, 1):   "May Bank Holiday",
+		date(2023, 6, 5):   "June Bank Holiday",
+		date(2023, 8, 7):   "August Bank Holiday",
+		date(2023, 10, 30): "October Bank Holiday",
+		date(2023, 12, 25): "Christmas Day",
+		date(2023, 12, 26): "St. Stephen's Day",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2030, 2, 1), true},  // St. Brigid's Day on a Friday
+		{date(2030, 2, 4), false}, // not moved to Monday
+		{date(2022, 2, 7), false}, // before St. Brigid's Day
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	workdays := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2029, 3, 16), true},  // Friday before St. Patrick's Day on Saturday
+		{date(2029, 3, 19), false}, // St. Patrick's Day on Saturday
+		{date(2021, 12, 24), true}, // Friday before Christmas Day on Saturday
+		{date(2021, 12, 27), false},
+		{date(2021, 12, 28), false}, // St. Stephen's Day on Sunday
+		{date(2021, 12, 29), true},
+	}
+
+	for _, test := range workdays {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Israel
+//
+// Jewish holidays begin at sundown on the evening before the Gregorian dates
+// reported here, which are the days of rest.
+var (
+	IL_RoshHashanah    = named(NewHolidayHebrew(7, 1), "Rosh Hashanah")
+	IL_RoshHashanah2   = named(NewHolidayHebrew(7, 2), "Rosh Hashanah")
+	IL_YomKippur       = named(NewHolidayHebrew(7, 10), "Yom Kippur")
+	IL_Sukkot          = named(NewHolidayHebrew(7, 15), "Sukkot")
+	IL_SimchatTorah    = named(NewHolidayHebrew(7, 22), "Simchat Torah")
+	IL_Passover        = named(NewHolidayHebrew(1, 15), "Pesach")
+	IL_Passover7       = named(NewHolidayHebrew(1, 21), "Shvi'i shel Pesach")
+	IL_IndependenceDay = Holiday{Name: "Yom Ha'atzmaut", Func: calculateYomHaatzmaut, ValidFrom: 1949}
+	IL_Shavuot         = named(NewHolidayHebrew(3, 6), "Shavuot")
+)
+
+var israeliHolidays = []Holiday{
+	IL_RoshHashanah,
+	IL_RoshHashanah2,
+	IL_YomKippur,
+	IL_Sukkot,
+	IL_SimchatTorah,
+	IL_Passover,
+	IL_Passover7,
+	IL_IndependenceDay,
+	IL_Shavuot,
+}
+
+// hebrewEpoch is the fixed day number of 1 Tishri AM 1, where day 1 is
+// January 1st of year 1 in the proleptic Gregorian calendar.
+const hebrewEpoch = -1373427
+
+// unixFixedDay is the fixed day number of January 1st, 1970.
+const unixFixedDay = 719163
+
+// hebrewElapsedDays reports the number of days from the epoch to the molad of
+// Tishri of the Hebrew year, delayed when it would fall on a Sunday,
+// Wednesday or Friday.
+func hebrewElapsedDays(year int) int {
+	months := (235*year - 234) / 19
+	parts := 12084 + 13753*months
+	day := 29*months + parts/25920
+	if (3*(day+1))%7 < 3 {
+		day++
+	}
+	return day
+}
+
+// hebrewNewYear reports the fixed day number of 1 Tishri of the Hebrew year.
+func hebrewNewYear(year int) int {
+	ny0 := hebrewElapsedDays(year - 1)
+	ny1 := hebrewElapsedDays(year)
+	ny2 := hebrewElapsedDays(year + 1)
+	// keep the length of the adjacent years within the allowed range
+	delay := 0
+	if ny2-ny1 == 356 {
+		delay = 2
+	} else if ny1-ny0 == 382 {
+		delay = 1
+	}
+	return hebrewEpoch + ny1 + delay
+}
+
+// fixedDate converts a fixed day number to a date.
+func fixedDate(day int, loc *time.Location) time.Time {
+	return time.Date(1970, time.January, 1+day-unixFixedDay, 0, 0, 0, 0, loc)
+}
+
+// Rosh Hashanah is 1 Tishri, which falls in the autumn of the Gregorian year.
+func calculateRoshHashanah(year int, loc *time.Location) (time.Month, int) {
+	day := fixedDate(hebrewNewYear(year+3761), loc)
+	return day.Month(), day.Day()
+}
+
+// hebrewMonthLength reports the number of days in a month of the Hebrew year.
+func hebrewMonthLength(year, month int) int {
+	days := hebrewNewYear(year+1) - hebrewNewYear(year)
+	switch {
+	case month == 8 && days%10 == 5:
+		// Heshvan is long in a complete year
+		return 30
+	case month == 9 && days%10 == 3:
+		// Kislev is short in a deficient year
+		return 29
+	case month == 12 && hebrewLeapYear(year):
+		// Adar I
+		return 30
+	case month%2 == 0 || month == 13:
+		return 29
+	}
+	return 30
+}
+
+// hebrewLeapYear reports whether the Hebrew year has a 13th month.
+func hebrewLeapYear(year int) bool {
+	return (7*year+1)%19 < 7
+}
+
+// hebrewDate converts a date to the year, month and day of the Hebrew
+// calendar.
+func hebrewDate(date time.Time) (year, month, day int) {
+	f := fixedDay(date)
+	year = date.Year() + 3761
+	if f < hebrewNewYear(year) {
+		year--
+	}
+	last := 12
+	if hebrewLeapYear(year) {
+		last = 13
+	}
+	// the year starts with Tishri, the 7th month
+	day = f - hebrewNewYear(year) + 1
+	for month = 7; day > hebrewMonthLength(year, month); {
+		day -= hebrewMonthLength(year, month)
+		if month++; month > last {
+			month = 1
+		}
+	}
+	return year, month, day
+}
+
+// passover reports the date of 15 Nisan, which is always 163 days before the
+// following Rosh Hashanah.
+func passover(year int, loc *time.Location) time.Time {
+	return fixedDate(hebrewNewYear(year+3761)-163, loc)
+}
+
+// Passover (Pesach) is 15 Nisan.
+func calculatePassover(year int, loc *time.Location) (time.Month, int) {
+	day := passover(year, loc)
+	return day.Month(), day.Day()
+}
+
+// Yom Ha'atzmaut is 5 Iyar. It is brought forward to Thursday when it would
+// fall on a Friday or Saturday, and since 2004 postponed to Tuesday when it
+// would fall on a Monday.
+func calculateYomHaatzmaut(year int, loc *time.Location) (time.Month, int) {
+	day := passover(year, loc).AddDate(0, 0, 20)
+	switch day.Weekday() {
+	case time.Friday:
+		day = day.AddDate(0, 0, -1)
+	case time.Saturday:
+		day = day.AddDate(0, 0, -2)
+	case time.Monday:
+		if year >= 2004 {
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+	return day.Month(), day.Day()
+}
+
+// AddIsraeliHolidays adds all Israeli holidays to Calendar
+func AddIsraeliHolidays(c *Calendar) {
+	c.AddHolidays(israeliHolidays...)
+}
+
+// NewIsraeliCalendar creates a new Calendar with the Israeli holidays and a
+// work week from Sunday to Thursday. Holidays are not moved when they fall on
+// a weekend.
+func NewIsraeliCalendar() *Calendar {
+	c := NewCalendar()
+	c.SetWeekmask([7]bool{true, true, true, true, true, false, false})
+	c.Observed = ObservedExact
+	AddIsraeliHolidays(c)
+	return c
+}
@@ -0,0 +1,99 @@
+package cal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsraeliHolidays(t *testing.T) {
+	c := NewIsraeliCalendar()
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2023, 4, 6, 12, 0, 0, 0, time.UTC), true},   // Pesach
+		{time.Date(2023, 4, 12, 12, 0, 0, 0, time.UTC), true},  // Shvi'i shel Pesach
+		{time.Date(2023, 4, 26, 12, 0, 0, 0, time.UTC), true},  // Yom Ha'atzmaut
+		{time.Date(2023, 5, 26, 12, 0, 0, 0, time.UTC), true},  // Shavuot
+		{time.Date(2023, 9, 16, 12, 0, 0, 0, time.UTC), true},  // Rosh Hashanah
+		{time.Date(2023, 9, 17, 12, 0, 0, 0, time.UTC), true},  // Rosh Hashanah
+		{time.Date(2023, 9, 25, 12, 0, 0, 0, time.UTC), true},  // Yom Kippur
+		{time.Date(2023, 9, 30, 12, 0, 0, 0, time.UTC), true},  // Sukkot
+		{time.Date(2023, 10, 7, 12, 0, 0, 0, time.UTC), true},  // Simchat Torah
+		{time.Date(2024, 4, 23, 12, 0, 0, 0, time.UTC), true},  // Pesach
+		{time.Date(2024, 5, 13, 12, 0, 0, 0, time.UTC), false}, // 5 Iyar is a Monday
+		{time.Date(2024, 5, 14, 12, 0, 0, 0, time.UTC), true},  // Yom Ha'atzmaut
+		{time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC), true},  // Rosh Hashanah
+		{time.Date(2024, 10, 12, 12, 0, 0, 0, time.UTC), true}, // Yom Kippur
+		{time.Date(2025, 10, 2, 12, 0, 0, 0, time.UTC), true},  // Yom Kippur
+		{time.Date(2020, 9, 28, 12, 0, 0, 0, time.UTC), true},  // Yom Kippur
+		{time.Date(2016, 10, 12, 12, 0, 0, 0, time.UTC), true}, // Yom Kippur
+		{time.Date(2023, 12, 25, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestHebrewDate(t *testing.T) {
+	tests := []struct {
+		t                time.Time
+		year, month, day int
+	}{
+		{time.Date(2023, 9, 16, 12, 0, 0, 0, time.UTC), 5784, 7, 1},  // Rosh Hashanah
+		{time.Date(2023, 12, 8, 12, 0, 0, 0, time.UTC), 5784, 9, 25}, // Hanukkah
+		{time.Date(2024, 1, 25, 12, 0, 0, 0, time.UTC), 5784, 11, 15},
+		{time.Date(2024, 3, 24, 12, 0, 0, 0, time.UTC), 5784, 13, 14}, // Purim in a leap year
+		{time.Date(2023, 3, 7, 12, 0, 0, 0, time.UTC), 5783, 12, 14},  // Purim
+		{time.Date(2024, 9, 2, 12, 0, 0, 0, time.UTC), 5784, 5, 29},
+		{time.Date(2024, 10, 2, 12, 0, 0, 0, time.UTC), 5784, 6, 29},
+	}
+
+	for _, test := range tests {
+		y, m, d := hebrewDate(test.t)
+		if y != test.year || m != test.month || d != test.day {
+			t.Errorf("got: %d-%d-%d; want: %d-%d-%d (%s)", y, m, d, test.year, test.month, test.day, test.t)
+		}
+	}
+}
+
+func TestNewHolidayHebrew(t *testing.T) {
+	purim := NewHolidayHebrew(12, 14)
+	c := NewCalendar()
+	c.AddHoliday(purim)
+	if !c.IsHoliday(time.Date(2023, 3, 7, 12, 0, 0, 0, time.UTC)) {
+		t.Error("got: false; want: true (14 Adar 5783)")
+	}
+	if c.IsHoliday(time.Date(2024, 3, 24, 12, 0, 0, 0, time.UTC)) {
+		t.Error("got: true; want: false (14 Adar II 5784)")
+	}
+	if got, want := purim.String(), "day 14 of Hebrew month 12"; got != want {
+		t.Errorf("got: %q; want: %q", got, want)
+	}
+}
+
+func TestIsraeliWeekend(t *testing.T) {
+	c := NewIsraeliCalendar()
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2023, 1, 5, 12, 0, 0, 0, time.UTC), true},  // Thursday
+		{time.Date(2023, 1, 6, 12, 0, 0, 0, time.UTC), false}, // Friday
+		{time.Date(2023, 1, 7, 12, 0, 0, 0, time.UTC), false}, // Saturday
+		{time.Date(2023, 1, 8, 12, 0, 0, 0, time.UTC), true},  // Sunday
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Holidays in India
+//
+// The festivals follow the dates gazetted by the central government for the
+// years in indianFestivals. Holidays are never moved by an ObservedRule.
+var (
+	IN_RepublicDay     = observedExact(validFrom(NewNamedHoliday("Republic Day", time.January, 26), 1950))
+	IN_Holi            = observedExact(Holiday{Name: "Holi", FuncOK: calculateHoli})
+	IN_GoodFriday      = observedExact(ECB_GoodFriday)
+	IN_IdUlFitr        = observedExact(Holiday{Name: "Id-ul-Fitr", FuncOK: calculateIdUlFitr})
+	IN_Bakrid          = observedExact(Holiday{Name: "Id-ul-Zuha (Bakrid)", FuncOK: calculateBakrid})
+	IN_IndependenceDay = observedExact(validFrom(NewNamedHoliday("Independence Day", time.August, 15), 1947))
+	IN_GandhiJayanti   = observedExact(NewNamedHoliday("Mahatma Gandhi's Birthday", time.October, 2))
+	IN_Dussehra        = observedExact(Holiday{Name: "Dussehra", FuncOK: calculateDussehra})
+	IN_Diwali          = observedExact(Holiday{Name: "Diwali (Deepavali)", FuncOK: calculateDiwali})
+	IN_ChristmasDay    = observedExact(ECB_ChristmasDay)
+
+	// Holidays in some Indian states
+	IN_PuthanduTN       = observedExact(NewNamedHoliday("Puthandu", time.April, 14))
+	IN_MaharashtraDayMH = observedExact(validFrom(NewNamedHoliday("Maharashtra Day", time.May, 1), 1960))
+	IN_GujaratDayGJ     = observedExact(validFrom(NewNamedHoliday("Gujarat Day", time.May, 1), 1960))
+	IN_TelanganaDayTG   = observedExact(validFrom(NewNamedHoliday("Telangana Formation Day", time.June, 2), 2014))
+	IN_RajyotsavaKA     = observedExact(NewNamedHoliday("Kannada Rajyotsava", time.November, 1))
+)
+
+var indianHolidays = []Holiday{
+	IN_RepublicDay,
+	IN_Holi,
+	IN_GoodFriday,
+	IN_IdUlFitr,
+	IN_Bakrid,
+	IN_IndependenceDay,
+	IN_GandhiJayanti,
+	IN_Dussehra,
+	IN_Diwali,
+	IN_ChristmasDay,
+}
+
+// indianStateHolidays holds the additional holidays of a state by its ISO
+// 3166-2:IN code.
+var indianStateHolidays = map[string][]Holiday{
+	"GJ": {IN_GujaratDayGJ},
+	"KA": {IN_RajyotsavaKA},
+	"MH": {IN_MaharashtraDayMH},
+	"TG": {IN_TelanganaDayTG},
+	"TN": {IN_PuthanduTN},
+}
+
+// indianFestival holds the gazetted dates of the festivals of a year.
+type indianFestival struct {
+	holi     monthDay
+	idUlFitr monthDay
+	bakrid   monthDay
+	dussehra monthDay
+	diwali   monthDay
+}
+
+// indianFestivals holds the festivals as gazetted by the central government
+// for 2020 through 2026. The dates depend on the sighting of the moon and
+// local almanacs, so they are added as each year's list is published.
+var indianFestivals = map[int]indianFestival{
+	2020: {monthDay{time.March, 10}, monthDay{time.May, 25}, monthDay{time.August, 1}, monthDay{time.October, 25}, monthDay{time.November, 14}},
+	2021: {monthDay{time.March, 29}, monthDay{time.May, 14}, monthDay{time.July, 21}, monthDay{time.October, 15}, monthDay{time.November, 4}},
+	2022: {monthDay{time.March, 18}, monthDay{time.May, 3}, monthDay{time.July, 10}, monthDay{time.October, 5}, monthDay{time.October, 24}},
+	2023: {monthDay{time.March, 8}, monthDay{time.April, 22}, monthDay{time.June, 29}, monthDay{time.October, 24}, monthDay{time.November, 12}},
+	2024: {monthDay{time.March, 25}, monthDay{time.April, 11}, monthDay{time.June, 17}, monthDay{time.October, 12}, monthDay{time.October, 31}},
+	2025: {monthDay{time.March, 14}, monthDay{time.March, 31}, monthDay{time.June, 7}, monthDay{time.October, 2}, monthDay{time.October, 20}},
+	2026: {monthDay{time.March, 4}, monthDay{time.March, 21}, monthDay{time.May, 27}, monthDay{time.October, 20}, monthDay{time.November, 8}},
+}
+
+// Holi is the day after the full moon of Phalguna.
+func calculateHoli(year int, loc *time.Location) (time.Month, int, bool) {
+	f, ok := indianFestivals[year]
+	return f.holi.month, f.holi.day, ok
+}
+
+// Id-ul-Fitr is 1 Shawwal as sighted in India.
+func calculateIdUlFitr(year int, loc *time.Location) (time.Month, int, bool) {
+	f, ok := indianFestivals[year]
+	return f.idUlFitr.month, f.idUlFitr.day, ok
+}
+
+// Bakrid is 10 Dhu al-Hijjah as sighted in India.
+func calculateBakrid(year int, loc *time.Location) (time.Month, int, bool) {
+	f, ok := indianFestivals[year]
+	return f.bakrid.month, f.bakrid.day, ok
+}
+
+// Dussehra is the 10th day of the bright half of Ashvin.
+func calculateDussehra(year int, loc *time.Location) (time.Month, int, bool) {
+	f, ok := indianFestivals[year]
+	return f.dussehra.month, f.dussehra.day, ok
+}
+
+// Diwali is the new moon of Kartika.
+func calculateDiwali(year int, loc *time.Location) (time.Month, int, bool) {
+	f, ok := indianFestivals[year]
+	return f.diwali.month, f.diwali.day, ok
+}
+
+// AddIndianHolidays adds all Indian holidays to Calendar, along with those of
+// the state given by its ISO 3166-2:IN code. An empty state adds the national
+// holidays only.
+func AddIndianHolidays(c *Calendar, state string) error {
+	hs, ok := indianStateHolidays[state]
+	if !ok && state != "" {
+		return fmt.Errorf("cal: unknown Indian state %q", state)
+	}
+	c.AddHolidays(indianHolidays...)
+	c.AddHolidays(hs...)
+	return nil
+}
@@ -0,0 +1,58 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestIndianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("IN")...)
+
+	caltest.AssertHolidays(t, c, 2025, map[time.Time]string{
+		date(2025, 1, 26):  "Republic Day",
+		date(2025, 3, 14):  "Holi",
+		date(2025, 3, 31):  "Id-ul-Fitr",
+		date(2025, 4, 18):  "Good Friday",
+		date(2025, 6, 7):   "Id-ul-Zuha (Bakrid)",
+		date(2025, 8, 15):  "Independence Day",
+		date(2025, 10, 2):  "Dussehra",
+		date(2025, 10, 20): "Diwali (Deepavali)",
+		date(2025, 12, 25): "Christmas Day",
+	})
+}
+
+func TestIndianStateHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddIndianHolidays(c, "MH"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 5, 1), false},   // Maharashtra Day
+		{date(2024, 11, 1), true},   // Kannada Rajyotsava in Karnataka only
+		{date(2025, 10, 2), false},  // Gandhi Jayanti and Dussehra
+		{date(2022, 1, 26), false},  // Republic Day on Wednesday
+		{date(2020, 1, 27), true},   // Republic Day on Sunday is not moved
+		{date(2021, 8, 16), true},   // nor Independence Day on Sunday
+		{date(2019, 10, 8), true},   // before the festival table
+		{date(2024, 10, 31), false}, // Diwali
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	if err := cal.AddIndianHolidays(cal.NewCalendar(), "XX"); err == nil {
+		t.Error("got: nil; want: error for unknown state")
+	}
+}
@@ -0,0 +1,41 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Italy
+//
+// There is no substitute day off for a holiday that falls on a weekend.
+var (
+	IT_Capodanno            = observedExact(named(US_NewYear, "Capodanno"))
+	IT_Epifania             = observedExact(named(catholicEpiphany, "Epifania"))
+	IT_Pasquetta            = observedExact(named(ECB_EasterMonday, "Lunedì dell'Angelo"))
+	IT_Liberazione          = observedExact(NewNamedHoliday("Festa della Liberazione", time.April, 25))
+	IT_FestaDelLavoro       = observedExact(named(ECB_LabourDay, "Festa del Lavoro"))
+	IT_FestaDellaRepubblica = observedExact(NewNamedHoliday("Festa della Repubblica", time.June, 2))
+	IT_Ferragosto           = observedExact(named(catholicAssumption, "Ferragosto"))
+	IT_Ognissanti           = observedExact(named(catholicAllSaints, "Ognissanti"))
+	IT_Immacolata           = observedExact(named(catholicImmaculate, "Immacolata Concezione"))
+	IT_Natale               = observedExact(named(ECB_ChristmasDay, "Natale"))
+	IT_SantoStefano         = observedExact(named(ECB_ChristmasHoliday, "Santo Stefano"))
+)
+
+var italianHolidays = []Holiday{
+	IT_Capodanno,
+	IT_Epifania,
+	IT_Pasquetta,
+	IT_Liberazione,
+	IT_FestaDelLavoro,
+	IT_FestaDellaRepubblica,
+	IT_Ferragosto,
+	IT_Ognissanti,
+	IT_Immacolata,
+	IT_Natale,
+	IT_SantoStefano,
+}
+
+// AddItalianHolidays adds all Italian holidays to Calendar
+func AddItalianHolidays(c *Calendar) {
+	c.AddHolidays(italianHolidays...)
+}
@@ -0,0 +1,46 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestItalianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddItalianHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2020, map[time.Time]string{
+		date(2020, 1, 1):   "Capodanno",
+		date(2020, 1, 6):   "Epifania",
+		date(2020, 4, 13):  "Lunedì dell'Angelo",
+		date(2020, 4, 25):  "Festa della Liberazione",
+		date(2020, 5, 1):   "Festa del Lavoro",
+		date(2020, 6, 2):   "Festa della Repubblica",
+		date(2020, 8, 15):  "Ferragosto",
+		date(2020, 11, 1):  "Ognissanti",
+		date(2020, 12, 8):  "Immacolata Concezione",
+		date(2020, 12, 25): "Natale",
+		date(2020, 12, 26): "Santo Stefano",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2020, 4, 24), true},  // Friday before Festa della Liberazione
+		{date(2020, 5, 21), true},  // Ascension
+		{date(2020, 8, 14), true},  // Friday before Ferragosto
+		{date(2020, 11, 2), true},  // Monday after Ognissanti
+		{date(2021, 12, 27), true}, // Monday after Santo Stefano
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
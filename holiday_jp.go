@@ -0,0 +1,129 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Japan
+//
+// A holiday falling on a Sunday is observed on the next day that is not a
+// holiday (furikae kyūjitsu). The rules are those in force since 2007, with
+// the one-off holidays of the enthronement of 2019.
+var (
+	JP_Ganjitsu          = jpHoliday(named(US_NewYear, "元日"))
+	JP_SeijinNoHi        = jpHoliday(NewNamedHolidayFloat("成人の日", time.January, time.Monday, 2))
+	JP_KenkokuKinenNoHi  = jpHoliday(NewNamedHoliday("建国記念の日", time.February, 11))
+	JP_TennoTanjobi      = jpHoliday(validFrom(NewNamedHoliday("天皇誕生日", time.February, 23), 2020))
+	JP_ShunbunNoHi       = jpHoliday(NewNamedHolidayFunc("春分の日", calculateVernalEquinox))
+	JP_ShowaNoHi         = jpHoliday(NewNamedHoliday("昭和の日", time.April, 29))
+	JP_KenpoKinenbi      = jpHoliday(NewNamedHoliday("憲法記念日", time.May, 3))
+	JP_MidoriNoHi        = jpHoliday(NewNamedHoliday("みどりの日", time.May, 4))
+	JP_KodomoNoHi        = jpHoliday(NewNamedHoliday("こどもの日", time.May, 5))
+	JP_UmiNoHi           = jpHoliday(NewNamedHolidayFunc("海の日", calculateMarineDay))
+	JP_YamaNoHi          = jpHoliday(Holiday{Name: "山の日", Func: calculateMountainDay, ValidFrom: 2016})
+	JP_KeiroNoHi         = jpHoliday(NewNamedHolidayFloat("敬老の日", time.September, time.Monday, 3))
+	JP_ShubunNoHi        = jpHoliday(NewNamedHolidayFunc("秋分の日", calculateAutumnalEquinox))
+	JP_SportsNoHi        = jpHoliday(Holiday{Name: "スポーツの日", Func: calculateSportsDay, ValidFrom: 2020})
+	JP_BunkaNoHi         = jpHoliday(NewNamedHoliday("文化の日", time.November, 3))
+	JP_KinroKanshaNoHi   = jpHoliday(NewNamedHoliday("勤労感謝の日", time.November, 23))
+	JP_KokuminNoKyujitsu = Holiday{Name: "国民の休日", FuncOK: calculateCitizensHoliday}
+)
+
+var japaneseHolidays = []Holiday{
+	JP_Ganjitsu,
+	JP_SeijinNoHi,
+	JP_KenkokuKinenNoHi,
+	jpHoliday(Holiday{Name: "天皇誕生日", Month: time.December, Day: 23, ValidFrom: 1989, ValidTo: 2018}),
+	JP_TennoTanjobi,
+	JP_ShunbunNoHi,
+	JP_ShowaNoHi,
+	named(NewHolidayExact(2019, time.April, 30), "国民の休日"),
+	named(NewHolidayExact(2019, time.May, 1), "即位の日"),
+	named(NewHolidayExact(2019, time.May, 2), "国民の休日"),
+	JP_KenpoKinenbi,
+	JP_MidoriNoHi,
+	JP_KodomoNoHi,
+	JP_UmiNoHi,
+	JP_YamaNoHi,
+	JP_KeiroNoHi,
+	JP_ShubunNoHi,
+	jpHoliday(Holiday{Name: "体育の日", Month: time.October, Weekday: time.Monday, Offset: 2, ValidTo: 2019}),
+	JP_SportsNoHi,
+	named(NewHolidayExact(2019, time.October, 22), "即位礼正殿の儀"),
+	JP_BunkaNoHi,
+	JP_KinroKanshaNoHi,
+	JP_KokuminNoKyujitsu,
+}
+
+// jpHoliday returns a copy of the holiday that moves from a Sunday to the next
+// day without a holiday.
+func jpHoliday(h Holiday) Holiday {
+	return NewHolidayObserved(h, ObservedSundayToMonday)
+}
+
+// equinoxDay approximates the day of an equinox from its day in 1980, which
+// holds for the years 1980 through 2099.
+func equinoxDay(year int, day1980 float64) int {
+	return int(day1980 + 0.242194*float64(year-1980) - float64((year-1980)/4))
+}
+
+// Shunbun no Hi is the day of the vernal equinox.
+func calculateVernalEquinox(year int, loc *time.Location) (time.Month, int) {
+	return time.March, equinoxDay(year, 20.8431)
+}
+
+// Shūbun no Hi is the day of the autumnal equinox.
+func calculateAutumnalEquinox(year int, loc *time.Location) (time.Month, int) {
+	return time.September, equinoxDay(year, 23.2488)
+}
+
+// Umi no Hi is the third Monday of July, moved for the Tokyo Olympics in 2020
+// and 2021.
+func calculateMarineDay(year int, loc *time.Location) (time.Month, int) {
+	switch year {
+	case 2020:
+		return time.July, 23
+	case 2021:
+		return time.July, 22
+	}
+	day := WeekdayOnOrAfter(time.Date(year, time.July, 15, 0, 0, 0, 0, loc), time.Monday)
+	return day.Month(), day.Day()
+}
+
+// Yama no Hi is August 11th, moved for the Tokyo Olympics in 2020 and 2021.
+func calculateMountainDay(year int, loc *time.Location) (time.Month, int) {
+	switch year {
+	case 2020:
+		return time.August, 10
+	case 2021:
+		return time.August, 8
+	}
+	return time.August, 11
+}
+
+// Sports no Hi is the second Monday of October, moved for the Tokyo Olympics
+// in 2020 and 2021.
+func calculateSportsDay(year int, loc *time.Location) (time.Month, int) {
+	switch year {
+	case 2020:
+		return time.July, 24
+	case 2021:
+		return time.July, 23
+	}
+	day := WeekdayOnOrAfter(time.Date(year, time.October, 8, 0, 0, 0, 0, loc), time.Monday)
+	return day.Month(), day.Day()
+}
+
+// Kokumin no Kyūjitsu is a day between two holidays. Since the other
+// holidays of Golden Week were fixed in 2007, this only happens in September
+// when Keirō no Hi falls two days before Shūbun no Hi.
+func calculateCitizensHoliday(year int, loc *time.Location) (time.Month, int, bool) {
+	keiro := WeekdayOnOrAfter(time.Date(year, time.September, 15, 0, 0, 0, 0, loc), time.Monday)
+	_, shubun := calculateAutumnalEquinox(year, loc)
+	return time.September, keiro.Day() + 1, year >= 2007 && shubun-keiro.Day() == 2
+}
+
+// AddJapaneseHolidays adds all Japanese holidays to Calendar
+func AddJapaneseHolidays(c *Calendar) {
+	c.AddHolidays(japaneseHolidays...)
+}
@@ -0,0 +1,64 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestJapaneseHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddJapaneseHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2026, map[time.Time]string{
+		date(2026, 1, 1):   "元日",
+		date(2026, 1, 12):  "成人の日",
+		date(2026, 2, 11):  "建国記念の日",
+		date(2026, 2, 23):  "天皇誕生日",
+		date(2026, 3, 20):  "春分の日",
+		date(2026, 4, 29):  "昭和の日",
+		date(2026, 5, 3):   "憲法記念日",
+		date(2026, 5, 4):   "みどりの日",
+		date(2026, 5, 5):   "こどもの日",
+		date(2026, 7, 20):  "海の日",
+		date(2026, 8, 11):  "山の日",
+		date(2026, 9, 21):  "敬老の日",
+		date(2026, 9, 22):  "国民の休日",
+		date(2026, 9, 23):  "秋分の日",
+		date(2026, 10, 12): "スポーツの日",
+		date(2026, 11, 3):  "文化の日",
+		date(2026, 11, 23): "勤労感謝の日",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2026, 5, 6), false}, // 憲法記念日 on Sunday moved past Golden Week
+		{date(2026, 5, 7), true},
+		{date(2023, 1, 2), false},   // 元日 on Sunday
+		{date(2021, 7, 22), false},  // 海の日 moved for the Olympics
+		{date(2021, 7, 23), false},  // スポーツの日 moved for the Olympics
+		{date(2021, 10, 11), true},  // no スポーツの日 in October
+		{date(2019, 10, 14), false}, // 体育の日
+		{date(2015, 9, 22), false},  // 国民の休日
+		{date(2024, 9, 23), false},  // 秋分の日 on Sunday
+		{date(2024, 3, 20), false},  // 春分の日
+		{date(2018, 12, 24), false}, // 天皇誕生日 on Sunday
+		{date(2019, 4, 30), false},  // 国民の休日
+		{date(2019, 5, 1), false},   // 即位の日
+		{date(2019, 5, 2), false},   // 国民の休日
+		{date(2019, 5, 6), false},   // こどもの日 on Sunday
+		{date(2019, 10, 22), false}, // 即位礼正殿の儀
+		{date(2020, 5, 1), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
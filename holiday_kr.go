@@ -0,0 +1,107 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in South Korea
+//
+// Some holidays give a substitute holiday on the next work day without a
+// holiday when they fall on a weekend or on another holiday: Seollal and
+// Chuseok (on a Sunday only) and Children's Day since 2014, the national days
+// since 2021, and Buddha's Birthday and Christmas since 2023.
+var (
+	KR_Sinjeong       = NewHolidayObserved(named(US_NewYear, "신정"), ObservedExact)
+	KR_Seollal        = krHoliday(Holiday{Name: "설날", FuncOK: calculateLunarNewYear}, krObservedLunar)
+	KR_SeollalEve     = krHoliday(named(NewHolidayRelative(KR_Seollal, -1), "설날"), krObservedLunar)
+	KR_SeollalAfter   = krHoliday(named(NewHolidayRelative(KR_Seollal, 1), "설날"), krObservedLunar)
+	KR_Samiljeol      = krHoliday(NewNamedHoliday("삼일절", time.March, 1), krObservedNational)
+	KR_Eorininal      = krHoliday(NewNamedHoliday("어린이날", time.May, 5), krObservedChildren)
+	KR_BuddhaBirthday = krHoliday(Holiday{Name: "부처님 오신 날", FuncOK: calculateKoreanBuddhasBirthday}, krObservedBuddha)
+	KR_Hyeonchungil   = NewHolidayObserved(NewNamedHoliday("현충일", time.June, 6), ObservedExact)
+	KR_Gwangbokjeol   = krHoliday(NewNamedHoliday("광복절", time.August, 15), krObservedNational)
+	KR_Chuseok        = krHoliday(Holiday{Name: "추석", FuncOK: calculateMidAutumn}, krObservedLunar)
+	KR_ChuseokEve     = krHoliday(named(NewHolidayRelative(KR_Chuseok, -1), "추석"), krObservedLunar)
+	KR_ChuseokAfter   = krHoliday(named(NewHolidayRelative(KR_Chuseok, 1), "추석"), krObservedLunar)
+	KR_Gaecheonjeol   = krHoliday(NewNamedHoliday("개천절", time.October, 3), krObservedNational)
+	KR_Hangeulnal     = krHoliday(validFrom(NewNamedHoliday("한글날", time.October, 9), 2013), krObservedNational)
+	KR_Christmas      = krHoliday(named(ECB_ChristmasDay, "기독탄신일"), krObservedChristmas)
+)
+
+var southKoreanHolidays = []Holiday{
+	KR_Sinjeong,
+	KR_SeollalEve,
+	KR_Seollal,
+	KR_SeollalAfter,
+	KR_Samiljeol,
+	KR_Eorininal,
+	KR_BuddhaBirthday,
+	KR_Hyeonchungil,
+	KR_Gwangbokjeol,
+	KR_ChuseokEve,
+	KR_Chuseok,
+	KR_ChuseokAfter,
+	KR_Gaecheonjeol,
+	KR_Hangeulnal,
+	KR_Christmas,
+}
+
+// krHoliday returns a copy of the holiday that is observed according to fn.
+func krHoliday(h Holiday, fn ObservedFn) Holiday {
+	h.ObservedFunc = fn
+	return h
+}
+
+// Buddha's Birthday follows the Korean lunar calendar, which puts it a day
+// after the Chinese one in some years.
+func calculateKoreanBuddhasBirthday(year int, loc *time.Location) (time.Month, int, bool) {
+	if year == 2023 {
+		return time.May, 27, true
+	}
+	return calculateBuddhasBirthday(year, loc)
+}
+
+// krSubstitute reports the day on which a holiday falling on the given date is
+// observed: from the given year, the next work day without a holiday when it
+// falls on one of the weekdays or, if overlap is set, on another holiday.
+func krSubstitute(date time.Time, c *Calendar, since int, overlap bool, weekdays ...time.Weekday) time.Time {
+	if date.Year() < since {
+		return date
+	}
+	if !hasWeekday(weekdays, date.Weekday()) && !(overlap && len(c.HolidaysOn(date)) > 1) {
+		return date
+	}
+	return c.nextFreeDay(date)
+}
+
+// krObservedLunar substitutes Seollal and Chuseok falling on a Sunday or on
+// another holiday.
+func krObservedLunar(date time.Time, c *Calendar) time.Time {
+	return krSubstitute(date, c, 2014, true, time.Sunday)
+}
+
+// krObservedChildren substitutes Children's Day falling on a weekend.
+func krObservedChildren(date time.Time, c *Calendar) time.Time {
+	return krSubstitute(date, c, 2014, false, time.Saturday, time.Sunday)
+}
+
+// krObservedNational substitutes the national days falling on a weekend.
+func krObservedNational(date time.Time, c *Calendar) time.Time {
+	return krSubstitute(date, c, 2021, false, time.Saturday, time.Sunday)
+}
+
+// krObservedBuddha substitutes Buddha's Birthday falling on a weekend or on
+// another holiday, such as Children's Day in 2025.
+func krObservedBuddha(date time.Time, c *Calendar) time.Time {
+	return krSubstitute(date, c, 2023, true, time.Saturday, time.Sunday)
+}
+
+// krObservedChristmas substitutes Christmas falling on a weekend.
+func krObservedChristmas(date time.Time, c *Calendar) time.Time {
+	return krSubstitute(date, c, 2023, false, time.Saturday, time.Sunday)
+}
+
+// AddSouthKoreanHolidays adds all South Korean holidays to Calendar
+func AddSouthKoreanHolidays(c *Calendar) {
+	c.AddHolidays(southKoreanHolidays...)
+}
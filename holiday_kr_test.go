@@ -0,0 +1,63 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestSouthKoreanHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("KR")...)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 1, 1):   "신정",
+		date(2024, 2, 9):   "설날",
+		date(2024, 2, 10):  "설날",
+		date(2024, 2, 11):  "설날",
+		date(2024, 3, 1):   "삼일절",
+		date(2024, 5, 5):   "어린이날",
+		date(2024, 5, 15):  "부처님 오신 날",
+		date(2024, 6, 6):   "현충일",
+		date(2024, 8, 15):  "광복절",
+		date(2024, 9, 16):  "추석",
+		date(2024, 9, 17):  "추석",
+		date(2024, 9, 18):  "추석",
+		date(2024, 10, 3):  "개천절",
+		date(2024, 10, 9):  "한글날",
+		date(2024, 12, 25): "기독탄신일",
+	})
+}
+
+func TestSouthKoreanSubstitutes(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddSouthKoreanHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 2, 12), false}, // 설날 on Sunday
+		{date(2024, 2, 13), true},
+		{date(2024, 5, 6), false},  // 어린이날 on Sunday
+		{date(2013, 2, 12), true},  // 설날 on Sunday before 2014
+		{date(2020, 10, 5), true},  // 개천절 on Saturday before 2021
+		{date(2021, 8, 16), false}, // 광복절 on Sunday
+		{date(2023, 5, 29), false}, // 부처님 오신 날 on Saturday (May 27)
+		{date(2025, 5, 5), false},  // 어린이날 and 부처님 오신 날
+		{date(2025, 5, 6), false},
+		{date(2025, 5, 7), true},
+		{date(2017, 10, 6), false}, // 추석 joined with 개천절
+		{date(2026, 6, 8), true},   // 현충일 has no substitute
+		{date(2022, 1, 3), true},   // nor 신정
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Luxembourg
+//
+// A holiday falling on a Sunday gives a compensatory day off to be taken
+// within three months, which is not a fixed date, so holidays are kept on
+// their own day.
+var (
+	LU_NewYear      = observedExact(US_NewYear)
+	LU_EasterMonday = observedExact(catholicEasterMonday)
+	LU_LabourDay    = observedExact(ECB_LabourDay)
+	LU_EuropeDay    = observedExact(Holiday{Name: "Europe Day", Month: time.May, Day: 9, ValidFrom: 2019})
+	LU_Ascension    = observedExact(catholicAscension)
+	LU_WhitMonday   = observedExact(catholicWhitMonday)
+	LU_NationalDay  = observedExact(NewNamedHoliday("National Day", time.June, 23))
+	LU_Assumption   = observedExact(catholicAssumption)
+	LU_AllSaints    = observedExact(catholicAllSaints)
+	LU_Christmas    = observedExact(ECB_ChristmasDay)
+	LU_StStephen    = observedExact(named(ECB_ChristmasHoliday, "St. Stephen's Day"))
+)
+
+var luxembourgHolidays = []Holiday{
+	LU_NewYear,
+	LU_EasterMonday,
+	LU_LabourDay,
+	LU_EuropeDay,
+	LU_Ascension,
+	LU_WhitMonday,
+	LU_NationalDay,
+	LU_Assumption,
+	LU_AllSaints,
+	LU_Christmas,
+	LU_StStephen,
+}
+
+// AddLuxembourgHolidays adds all Luxembourg holidays to Calendar
+func AddLuxembourgHolidays(c *Calendar) {
+	c.AddHolidays(luxembourgHolidays...)
+}
@@ -0,0 +1,51 @@
+package cal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLuxembourgHolidays(t *testing.T) {
+	c := NewCalendar()
+	AddLuxembourgHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC), true},   // New Year
+		{time.Date(2018, 4, 2, 12, 0, 0, 0, time.UTC), true},   // Easter Monday
+		{time.Date(2018, 5, 9, 12, 0, 0, 0, time.UTC), false},  // Europe Day (not before 2019)
+		{time.Date(2019, 5, 9, 12, 0, 0, 0, time.UTC), true},   // Europe Day
+		{time.Date(2019, 5, 30, 12, 0, 0, 0, time.UTC), true},  // Ascension
+		{time.Date(2018, 6, 23, 12, 0, 0, 0, time.UTC), true},  // National Day
+		{time.Date(2019, 6, 23, 12, 0, 0, 0, time.UTC), true},  // National Day
+		{time.Date(2019, 8, 15, 12, 0, 0, 0, time.UTC), true},  // Assumption
+		{time.Date(2019, 11, 1, 12, 0, 0, 0, time.UTC), true},  // All Saints
+		{time.Date(2019, 12, 26, 12, 0, 0, 0, time.UTC), true}, // St. Stephen
+		{time.Date(2019, 4, 19, 12, 0, 0, 0, time.UTC), false}, // Good Friday
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	workdays := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2018, 6, 22, 12, 0, 0, 0, time.UTC), true}, // Friday before National Day
+		{time.Date(2019, 6, 24, 12, 0, 0, 0, time.UTC), true}, // Monday after National Day
+		{time.Date(2020, 8, 14, 12, 0, 0, 0, time.UTC), true}, // Friday before Assumption
+	}
+
+	for _, test := range workdays {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Mexico
+//
+// Since 2006 Constitution Day, Benito Juárez's birthday and Revolution Day are
+// kept on a Monday in place of their historical dates.
+var (
+	MX_AnoNuevo           = named(US_NewYear, "Año Nuevo")
+	MX_Constitucion       = validFrom(NewNamedHolidayFloat("Día de la Constitución", time.February, time.Monday, 1), 2006)
+	MX_BenitoJuarez       = validFrom(NewNamedHolidayFloat("Natalicio de Benito Juárez", time.March, time.Monday, 3), 2006)
+	MX_DiaDelTrabajo      = named(ECB_LabourDay, "Día del Trabajo")
+	MX_Independencia      = NewNamedHoliday("Día de la Independencia", time.September, 16)
+	MX_Revolucion         = validFrom(NewNamedHolidayFloat("Día de la Revolución", time.November, time.Monday, 3), 2006)
+	MX_TransmisionDePoder = Holiday{Name: "Transmisión del Poder Ejecutivo Federal", Func: calculateTransmisionDePoder, ValidFrom: 1934, Interval: 6}
+	MX_Navidad            = named(ECB_ChristmasDay, "Navidad")
+)
+
+var mexicanHolidays = []Holiday{
+	MX_AnoNuevo,
+	validTo(NewNamedHoliday("Día de la Constitución", time.February, 5), 2005),
+	MX_Constitucion,
+	validTo(NewNamedHoliday("Natalicio de Benito Juárez", time.March, 21), 2005),
+	MX_BenitoJuarez,
+	MX_DiaDelTrabajo,
+	MX_Independencia,
+	validTo(NewNamedHoliday("Día de la Revolución", time.November, 20), 2005),
+	MX_Revolucion,
+	MX_TransmisionDePoder,
+	MX_Navidad,
+}
+
+// The federal executive power is transmitted every six years, on December 1st
+// until 2018 and on October 1st since 2024.
+func calculateTransmisionDePoder(year int, loc *time.Location) (time.Month, int) {
+	if year >= 2024 {
+		return time.October, 1
+	}
+	return time.December, 1
+}
+
+// AddMexicanHolidays adds all Mexican holidays to Calendar
+func AddMexicanHolidays(c *Calendar) {
+	c.AddHolidays(mexicanHolidays...)
+}
@@ -0,0 +1,51 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestMexicanHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("MX")...)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 1, 1):   "Año Nuevo",
+		date(2024, 2, 5):   "Día de la Constitución",
+		date(2024, 3, 18):  "Natalicio de Benito Juárez",
+		date(2024, 5, 1):   "Día del Trabajo",
+		date(2024, 9, 16):  "Día de la Independencia",
+		date(2024, 10, 1):  "Transmisión del Poder Ejecutivo Federal",
+		date(2024, 11, 18): "Día de la Revolución",
+		date(2024, 12, 25): "Navidad",
+	})
+}
+
+func TestMexicanMovedHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddMexicanHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2005, 3, 21), true}, // Benito Juárez before 2006
+		{date(2006, 3, 21), false},
+		{date(2006, 3, 20), true},
+		{date(2018, 12, 1), true}, // Transmisión del Poder
+		{date(2019, 12, 1), false},
+		{date(2030, 10, 1), true},
+		{date(2025, 11, 20), false}, // Revolución on the third Monday
+		{date(2025, 11, 17), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
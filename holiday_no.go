@@ -0,0 +1,43 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Norway
+//
+// There is no day off in lieu of a holiday on a Saturday or Sunday.
+var (
+	NO_ForsteNyttarsdag     = observedExact(named(US_NewYear, "Første nyttårsdag"))
+	NO_Skjaertorsdag        = observedExact(named(NewHolidayEasterOffset(-3), "Skjærtorsdag"))
+	NO_Langfredag           = observedExact(named(ECB_GoodFriday, "Langfredag"))
+	NO_ForstePaskedag       = observedExact(named(NewHolidayEasterOffset(0), "Første påskedag"))
+	NO_AndrePaskedag        = observedExact(named(ECB_EasterMonday, "Andre påskedag"))
+	NO_ArbeidernesDag       = observedExact(named(ECB_LabourDay, "Arbeidernes dag"))
+	NO_Grunnlovsdag         = observedExact(NewNamedHoliday("Grunnlovsdag", time.May, 17))
+	NO_KristiHimmelfartsdag = observedExact(named(catholicAscension, "Kristi himmelfartsdag"))
+	NO_ForstePinsedag       = observedExact(named(NewHolidayEasterOffset(49), "Første pinsedag"))
+	NO_AndrePinsedag        = observedExact(named(catholicWhitMonday, "Andre pinsedag"))
+	NO_ForsteJuledag        = observedExact(named(ECB_ChristmasDay, "Første juledag"))
+	NO_AndreJuledag         = observedExact(named(ECB_ChristmasHoliday, "Andre juledag"))
+)
+
+var norwegianHolidays = []Holiday{
+	NO_ForsteNyttarsdag,
+	NO_Skjaertorsdag,
+	NO_Langfredag,
+	NO_ForstePaskedag,
+	NO_AndrePaskedag,
+	NO_ArbeidernesDag,
+	NO_Grunnlovsdag,
+	NO_KristiHimmelfartsdag,
+	NO_ForstePinsedag,
+	NO_AndrePinsedag,
+	NO_ForsteJuledag,
+	NO_AndreJuledag,
+}
+
+// AddNorwegianHolidays adds all Norwegian holidays to Calendar
+func AddNorwegianHolidays(c *Calendar) {
+	c.AddHolidays(norwegianHolidays...)
+}
@@ -0,0 +1,45 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestNorwegianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddNorwegianHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "Første nyttårsdag",
+		date(2023, 4, 6):   "Skjærtorsdag",
+		date(2023, 4, 7):   "Langfredag",
+		date(2023, 4, 9):   "Første påskedag",
+		date(2023, 4, 10):  "Andre påskedag",
+		date(2023, 5, 1):   "Arbeidernes dag",
+		date(2023, 5, 17):  "Grunnlovsdag",
+		date(2023, 5, 18):  "Kristi himmelfartsdag",
+		date(2023, 5, 28):  "Første pinsedag",
+		date(2023, 5, 29):  "Andre pinsedag",
+		date(2023, 12, 25): "Første juledag",
+		date(2023, 12, 26): "Andre juledag",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2020, 4, 14), true}, // Tuesday after Første and Andre påskedag
+		{date(2020, 6, 2), true},  // Tuesday after Første and Andre pinsedag
+		{date(2020, 5, 18), true}, // Monday after Grunnlovsdag
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
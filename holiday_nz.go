@@ -0,0 +1,185 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Holidays in New Zealand
+//
+// New Year's, Christmas and Boxing Day are "Mondayised": when they fall on a
+// weekend they are observed on the following Monday, or the Tuesday when the
+// Monday is already a holiday. Waitangi Day and ANZAC Day are Mondayised
+// since 2014.
+var (
+	NZ_NewYear         = NewHolidayObserved(named(US_NewYear, "New Year's Day"), ObservedMonday)
+	NZ_DayAfterNewYear = NewHolidayObserved(NewNamedHoliday("Day after New Year's Day", time.January, 2), ObservedMonday)
+	NZ_WaitangiDay     = NewHolidayObserved(Holiday{Name: "Waitangi Day", Month: time.February, Day: 6, ValidFrom: 2014}, ObservedMonday)
+	NZ_GoodFriday      = named(ECB_GoodFriday, "Good Friday")
+	NZ_EasterMonday    = named(ECB_EasterMonday, "Easter Monday")
+	NZ_AnzacDay        = NewHolidayObserved(Holiday{Name: "ANZAC Day", Month: time.April, Day: 25, ValidFrom: 2014}, ObservedMonday)
+	NZ_QueensBirthday  = Holiday{Name: "Queen's Birthday", Month: time.June, Weekday: time.Monday, Offset: 1, ValidTo: 2022}
+	NZ_KingsBirthday   = Holiday{Name: "King's Birthday", Month: time.June, Weekday: time.Monday, Offset: 1, ValidFrom: 2023}
+	NZ_Matariki        = Holiday{Name: "Matariki", FuncOK: calculateMatariki}
+	NZ_LabourDay       = NewNamedHolidayFloat("Labour Day", time.October, time.Monday, 4)
+	NZ_ChristmasDay    = NewHolidayObserved(named(ECB_ChristmasDay, "Christmas Day"), ObservedMonday)
+	NZ_BoxingDay       = NewHolidayObserved(named(ECB_ChristmasHoliday, "Boxing Day"), ObservedMonday)
+
+	// Regional anniversary days
+	NZ_AucklandAnniversary    = NewNamedHolidayFunc("Auckland Anniversary Day", calculateAucklandAnniversary)
+	NZ_WellingtonAnniversary  = NewNamedHolidayFunc("Wellington Anniversary Day", calculateWellingtonAnniversary)
+	NZ_NelsonAnniversary      = NewNamedHolidayFunc("Nelson Anniversary Day", calculateNelsonAnniversary)
+	NZ_TaranakiAnniversary    = NewNamedHolidayFloat("Taranaki Anniversary Day", time.March, time.Monday, 2)
+	NZ_OtagoAnniversary       = NewNamedHolidayFunc("Otago Anniversary Day", calculateOtagoAnniversary)
+	NZ_SouthlandAnniversary   = validFrom(named(NewHolidayEasterOffset(2), "Southland Anniversary Day"), 2012)
+	NZ_HawkesBayAnniversary   = named(NewHolidayRelative(NZ_LabourDay, -3), "Hawke's Bay Anniversary Day")
+	NZ_MarlboroughAnniversary = named(NewHolidayRelative(NZ_LabourDay, 7), "Marlborough Anniversary Day")
+	NZ_CanterburyAnniversary  = NewNamedHolidayFunc("Canterbury Anniversary Day", calculateCanterburyAnniversary)
+	NZ_ChathamAnniversary     = NewNamedHolidayFunc("Chatham Islands Anniversary Day", calculateChathamAnniversary)
+	NZ_WestlandAnniversary    = NewNamedHolidayFunc("Westland Anniversary Day", calculateWestlandAnniversary)
+)
+
+var newZealandHolidays = []Holiday{
+	NZ_NewYear,
+	NZ_DayAfterNewYear,
+	NewHolidayObserved(Holiday{Name: "Waitangi Day", Month: time.February, Day: 6, ValidTo: 2013}, ObservedExact),
+	NZ_WaitangiDay,
+	NZ_GoodFriday,
+	NZ_EasterMonday,
+	NewHolidayObserved(Holiday{Name: "ANZAC Day", Month: time.April, Day: 25, ValidTo: 2013}, ObservedExact),
+	NZ_AnzacDay,
+	NZ_QueensBirthday,
+	NZ_KingsBirthday,
+	NZ_Matariki,
+	NZ_LabourDay,
+	NZ_ChristmasDay,
+	NZ_BoxingDay,
+}
+
+// newZealandRegionHolidays holds the anniversary day kept in each region, by
+// ISO 3166-2 region code.
+var newZealandRegionHolidays = map[string][]Holiday{
+	"AUK": {NZ_AucklandAnniversary},
+	"BOP": {NZ_AucklandAnniversary},
+	"CAN": {NZ_CanterburyAnniversary},
+	"CIT": {NZ_ChathamAnniversary},
+	"GIS": {NZ_AucklandAnniversary},
+	"HKB": {NZ_HawkesBayAnniversary},
+	"MBH": {NZ_MarlboroughAnniversary},
+	"MWT": {NZ_WellingtonAnniversary},
+	"NSN": {NZ_NelsonAnniversary},
+	"NTL": {NZ_AucklandAnniversary},
+	"OTA": {NZ_OtagoAnniversary},
+	"STL": {NZ_SouthlandAnniversary},
+	"TAS": {NZ_NelsonAnniversary},
+	"TKI": {NZ_TaranakiAnniversary},
+	"WGN": {NZ_WellingtonAnniversary},
+	"WKO": {NZ_AucklandAnniversary},
+	"WTC": {NZ_WestlandAnniversary},
+}
+
+// matarikiDates holds the dates of Matariki for 2022 through 2052, as set out
+// in Schedule 1 of the Te Kāhui o Matariki Public Holiday Act 2022. The
+// holiday follows the Māori lunar calendar, so it cannot be calculated from a
+// simple rule, and there is no holiday in later years until the schedule is
+// extended.
+var matarikiDates = map[int]struct {
+	month time.Month
+	day   int
+}{
+	2022: {time.June, 24},
+	2023: {time.July, 14},
+	2024: {time.June, 28},
+	2025: {time.June, 20},
+	2026: {time.July, 10},
+	2027: {time.June, 25},
+	2028: {time.July, 14},
+	2029: {time.July, 6},
+	2030: {time.June, 21},
+	2031: {time.July, 11},
+	2032: {time.July, 2},
+	2033: {time.June, 24},
+	2034: {time.July, 7},
+	2035: {time.June, 29},
+	2036: {time.July, 18},
+	2037: {time.July, 10},
+	2038: {time.June, 25},
+	2039: {time.July, 15},
+	2040: {time.July, 6},
+	2041: {time.July, 19},
+	2042: {time.July, 11},
+	2043: {time.July, 3},
+	2044: {time.June, 24},
+	2045: {time.July, 7},
+	2046: {time.June, 29},
+	2047: {time.July, 19},
+	2048: {time.July, 3},
+	2049: {time.June, 25},
+	2050: {time.July, 15},
+	2051: {time.June, 30},
+	2052: {time.June, 21},
+}
+
+// Matariki is a Friday set in advance by statute.
+func calculateMatariki(year int, loc *time.Location) (time.Month, int, bool) {
+	d, ok := matarikiDates[year]
+	return d.month, d.day, ok
+}
+
+// nearestMonday reports the Monday closest to the given day of the year.
+func nearestMonday(year int, month time.Month, day int, loc *time.Location) (time.Month, int) {
+	d := WeekdayOnOrAfter(time.Date(year, month, day-3, 0, 0, 0, 0, loc), time.Monday)
+	return d.Month(), d.Day()
+}
+
+// Auckland Anniversary Day is the Monday nearest January 29th.
+func calculateAucklandAnniversary(year int, loc *time.Location) (time.Month, int) {
+	return nearestMonday(year, time.January, 29, loc)
+}
+
+// Wellington Anniversary Day is the Monday nearest January 22nd.
+func calculateWellingtonAnniversary(year int, loc *time.Location) (time.Month, int) {
+	return nearestMonday(year, time.January, 22, loc)
+}
+
+// Nelson Anniversary Day is the Monday nearest February 1st.
+func calculateNelsonAnniversary(year int, loc *time.Location) (time.Month, int) {
+	return nearestMonday(year, time.February, 1, loc)
+}
+
+// Otago Anniversary Day is the Monday nearest March 23rd.
+func calculateOtagoAnniversary(year int, loc *time.Location) (time.Month, int) {
+	return nearestMonday(year, time.March, 23, loc)
+}
+
+// Canterbury Anniversary Day is Show Day, the second Friday after the first
+// Tuesday of November.
+func calculateCanterburyAnniversary(year int, loc *time.Location) (time.Month, int) {
+	day := WeekdayOnOrAfter(time.Date(year, time.November, 1, 0, 0, 0, 0, loc), time.Tuesday).AddDate(0, 0, 10)
+	return day.Month(), day.Day()
+}
+
+// Chatham Islands Anniversary Day is the Monday nearest November 30th.
+func calculateChathamAnniversary(year int, loc *time.Location) (time.Month, int) {
+	return nearestMonday(year, time.November, 30, loc)
+}
+
+// Westland Anniversary Day is the Monday nearest December 1st.
+func calculateWestlandAnniversary(year int, loc *time.Location) (time.Month, int) {
+	return nearestMonday(year, time.December, 1, loc)
+}
+
+// AddNewZealandHolidays adds all New Zealand national holidays to Calendar,
+// along with the anniversary day of the given region (such as "WGN" for
+// Wellington). An empty region adds the national holidays only.
+func AddNewZealandHolidays(c *Calendar, region string) error {
+	hs, ok := newZealandRegionHolidays[region]
+	if !ok && region != "" {
+		return fmt.Errorf("cal: unknown New Zealand region %q", region)
+	}
+	c.AddHolidays(newZealandHolidays...)
+	c.AddHolidays(hs...)
+	return nil
+}
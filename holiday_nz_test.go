@@ -0,0 +1,91 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestNewZealandHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	if err := cal.AddNewZealandHolidays(c, "WGN"); err != nil {
+		t.Fatal(err)
+	}
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "New Year's Day",
+		date(2023, 1, 2):   "Day after New Year's Day",
+		date(2023, 1, 23):  "Wellington Anniversary Day",
+		date(2023, 2, 6):   "Waitangi Day",
+		date(2023, 4, 7):   "Good Friday",
+		date(2023, 4, 10):  "Easter Monday",
+		date(2023, 4, 25):  "ANZAC Day",
+		date(2023, 6, 5):   "King's Birthday",
+		date(2023, 7, 14):  "Matariki",
+		date(2023, 10, 23): "Labour Day",
+		date(2023, 12, 25): "Christmas Day",
+		date(2023, 12, 26): "Boxing Day",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2023, 1, 3), false},   // New Year's Day Mondayised past the day after
+		{date(2022, 1, 3), false},   // New Year's Day Mondayised
+		{date(2022, 1, 4), false},   // day after New Year's Day Mondayised
+		{date(2020, 4, 27), false},  // ANZAC Day Mondayised
+		{date(2009, 4, 27), true},   // ANZAC Day before Mondayisation
+		{date(2021, 2, 8), false},   // Waitangi Day Mondayised
+		{date(2021, 12, 28), false}, // Boxing Day Mondayised
+		{date(2021, 12, 24), true},
+		{date(2021, 6, 25), true},  // no Matariki before 2022
+		{date(2041, 7, 19), false}, // Matariki late in the schedule
+		{date(2052, 6, 21), false}, // last Matariki of the schedule
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestNewZealandRegionHolidays(t *testing.T) {
+	tests := []struct {
+		region string
+		t      time.Time
+		want   bool
+	}{
+		{"AUK", date(2023, 1, 30), true},  // Auckland Anniversary Day
+		{"AUK", date(2021, 2, 1), true},   // nearest Monday after January 29th
+		{"AUK", date(2023, 1, 23), false}, // Wellington Anniversary Day
+		{"NSN", date(2023, 1, 30), true},  // Nelson Anniversary Day
+		{"TKI", date(2023, 3, 13), true},  // Taranaki Anniversary Day
+		{"OTA", date(2023, 3, 20), true},  // Otago Anniversary Day
+		{"STL", date(2023, 4, 11), true},  // Southland Anniversary Day
+		{"HKB", date(2023, 10, 20), true}, // Hawke's Bay Anniversary Day
+		{"MBH", date(2023, 10, 30), true}, // Marlborough Anniversary Day
+		{"CAN", date(2023, 11, 17), true}, // Canterbury Anniversary Day
+		{"CIT", date(2023, 11, 27), true}, // Chatham Islands Anniversary Day
+		{"WTC", date(2023, 12, 4), true},  // Westland Anniversary Day
+	}
+
+	for _, test := range tests {
+		c := cal.NewCalendar()
+		if err := cal.AddNewZealandHolidays(c, test.region); err != nil {
+			t.Fatal(err)
+		}
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s %s)", got, test.want, test.region, test.t)
+		}
+	}
+
+	if err := cal.AddNewZealandHolidays(cal.NewCalendar(), "XX"); err == nil {
+		t.Error("got: nil; want: error for unknown region")
+	}
+}
@@ -0,0 +1,48 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Poland
+//
+// Employers grant another day off for a holiday on a Saturday, but the day
+// is not fixed, so holidays are kept on their own day.
+var (
+	PL_NowyRok                  = observedExact(named(US_NewYear, "Nowy Rok"))
+	PL_TrzechKroli              = observedExact(validFrom(named(catholicEpiphany, "Trzech Króli"), 2011))
+	PL_Wielkanoc                = observedExact(named(NewHolidayEasterOffset(0), "Wielkanoc"))
+	PL_PoniedzialekWielkanocny  = observedExact(named(ECB_EasterMonday, "Poniedziałek Wielkanocny"))
+	PL_SwietoPracy              = observedExact(named(ECB_LabourDay, "Święto Pracy"))
+	PL_KonstytucjiTrzeciegoMaja = observedExact(NewNamedHoliday("Święto Konstytucji 3 Maja", time.May, 3))
+	PL_ZieloneSwiatki           = observedExact(named(NewHolidayEasterOffset(49), "Zielone Świątki"))
+	PL_BozeCialo                = observedExact(named(catholicCorpusChristi, "Boże Ciało"))
+	PL_Wniebowziecie            = observedExact(named(catholicAssumption, "Wniebowzięcie Najświętszej Maryi Panny"))
+	PL_WszystkichSwietych       = observedExact(named(catholicAllSaints, "Wszystkich Świętych"))
+	PL_Niepodleglosci           = observedExact(NewNamedHoliday("Narodowe Święto Niepodległości", time.November, 11))
+	PL_Wigilia                  = observedExact(validFrom(NewNamedHoliday("Wigilia Bożego Narodzenia", time.December, 24), 2025))
+	PL_BozeNarodzenie           = observedExact(named(ECB_ChristmasDay, "Boże Narodzenie"))
+	PL_DrugiDzienSwiat          = observedExact(named(ECB_ChristmasHoliday, "Drugi dzień Bożego Narodzenia"))
+)
+
+var polishHolidays = []Holiday{
+	PL_NowyRok,
+	PL_TrzechKroli,
+	PL_Wielkanoc,
+	PL_PoniedzialekWielkanocny,
+	PL_SwietoPracy,
+	PL_KonstytucjiTrzeciegoMaja,
+	PL_ZieloneSwiatki,
+	PL_BozeCialo,
+	PL_Wniebowziecie,
+	PL_WszystkichSwietych,
+	PL_Niepodleglosci,
+	PL_Wigilia,
+	PL_BozeNarodzenie,
+	PL_DrugiDzienSwiat,
+}
+
+// AddPolishHolidays adds all Polish holidays to Calendar
+func AddPolishHolidays(c *Calendar) {
+	c.AddHolidays(polishHolidays...)
+}
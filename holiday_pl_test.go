@@ -0,0 +1,61 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestPolishHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddPolishHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "Nowy Rok",
+		date(2023, 1, 6):   "Trzech Króli",
+		date(2023, 4, 9):   "Wielkanoc",
+		date(2023, 4, 10):  "Poniedziałek Wielkanocny",
+		date(2023, 5, 1):   "Święto Pracy",
+		date(2023, 5, 3):   "Święto Konstytucji 3 Maja",
+		date(2023, 5, 28):  "Zielone Świątki",
+		date(2023, 6, 8):   "Boże Ciało",
+		date(2023, 8, 15):  "Wniebowzięcie Najświętszej Maryi Panny",
+		date(2023, 11, 1):  "Wszystkich Świętych",
+		date(2023, 11, 11): "Narodowe Święto Niepodległości",
+		date(2023, 12, 25): "Boże Narodzenie",
+		date(2023, 12, 26): "Drugi dzień Bożego Narodzenia",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2010, 1, 6), false},  // before Trzech Króli
+		{date(2025, 12, 24), true}, // Wigilia Bożego Narodzenia
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	workdays := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2020, 4, 14), true}, // Tuesday after Wielkanoc
+		{date(2020, 6, 1), true},  // Monday after Zielone Świątki
+		{date(2020, 8, 14), true}, // Friday before Wniebowzięcie
+	}
+
+	for _, test := range workdays {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
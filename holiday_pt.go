@@ -0,0 +1,54 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Portugal
+//
+// Carnaval is not a public holiday by law, so PT_Carnaval is not added by
+// AddPortugueseHolidays; add it to the calendar where it is kept. Páscoa is a
+// Sunday, and no holiday is moved to a weekday.
+var (
+	PT_AnoNovo                  = observedExact(named(US_NewYear, "Ano Novo"))
+	PT_Carnaval                 = observedExact(named(NewHolidayEasterOffset(-47), "Carnaval"))
+	PT_SextaFeiraSanta          = observedExact(named(ECB_GoodFriday, "Sexta-feira Santa"))
+	PT_Pascoa                   = observedExact(named(NewHolidayEasterOffset(0), "Páscoa"))
+	PT_DiaDaLiberdade           = observedExact(NewNamedHoliday("Dia da Liberdade", time.April, 25))
+	PT_DiaDoTrabalhador         = observedExact(named(ECB_LabourDay, "Dia do Trabalhador"))
+	PT_CorpoDeDeus              = observedExact(named(catholicCorpusChristi, "Corpo de Deus"))
+	PT_DiaDePortugal            = observedExact(NewNamedHoliday("Dia de Portugal", time.June, 10))
+	PT_Assuncao                 = observedExact(named(catholicAssumption, "Assunção de Nossa Senhora"))
+	PT_ImplantacaoRepublica     = observedExact(NewNamedHoliday("Implantação da República", time.October, 5))
+	PT_TodosOsSantos            = observedExact(named(catholicAllSaints, "Todos os Santos"))
+	PT_RestauracaoIndependencia = observedExact(NewNamedHoliday("Restauração da Independência", time.December, 1))
+	PT_ImaculadaConceicao       = observedExact(named(catholicImmaculate, "Imaculada Conceição"))
+	PT_Natal                    = observedExact(named(ECB_ChristmasDay, "Natal"))
+)
+
+var portugueseHolidays = []Holiday{
+	PT_AnoNovo,
+	PT_SextaFeiraSanta,
+	PT_Pascoa,
+	PT_DiaDaLiberdade,
+	PT_DiaDoTrabalhador,
+	PT_DiaDePortugal,
+	PT_Assuncao,
+	PT_ImaculadaConceicao,
+	PT_Natal,
+
+	// suspended from 2013 through 2015
+	validTo(PT_CorpoDeDeus, 2012),
+	validFrom(PT_CorpoDeDeus, 2016),
+	validTo(PT_ImplantacaoRepublica, 2012),
+	validFrom(PT_ImplantacaoRepublica, 2016),
+	validTo(PT_TodosOsSantos, 2012),
+	validFrom(PT_TodosOsSantos, 2016),
+	validTo(PT_RestauracaoIndependencia, 2012),
+	validFrom(PT_RestauracaoIndependencia, 2016),
+}
+
+// AddPortugueseHolidays adds all Portuguese holidays to Calendar
+func AddPortugueseHolidays(c *Calendar) {
+	c.AddHolidays(portugueseHolidays...)
+}
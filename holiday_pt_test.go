@@ -0,0 +1,72 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestPortugueseHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddPortugueseHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "Ano Novo",
+		date(2023, 4, 7):   "Sexta-feira Santa",
+		date(2023, 4, 9):   "Páscoa",
+		date(2023, 4, 25):  "Dia da Liberdade",
+		date(2023, 5, 1):   "Dia do Trabalhador",
+		date(2023, 6, 8):   "Corpo de Deus",
+		date(2023, 6, 10):  "Dia de Portugal",
+		date(2023, 8, 15):  "Assunção de Nossa Senhora",
+		date(2023, 10, 5):  "Implantação da República",
+		date(2023, 11, 1):  "Todos os Santos",
+		date(2023, 12, 1):  "Restauração da Independência",
+		date(2023, 12, 8):  "Imaculada Conceição",
+		date(2023, 12, 25): "Natal",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2014, 10, 5), false}, // suspended
+		{date(2012, 10, 5), true},
+		{date(2023, 2, 21), false}, // Carnaval
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	c.AddHoliday(cal.PT_Carnaval)
+	if !c.IsHoliday(date(2023, 2, 21)) {
+		t.Errorf("Expected Carnaval once added")
+	}
+}
+
+func TestPortugueseWorkdays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddPortugueseHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2023, 4, 10), true}, // Monday after Páscoa
+		{date(2023, 6, 9), true},  // Friday before Dia de Portugal
+		{date(2024, 12, 2), true}, // Monday after Restauração da Independência
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Romania
+//
+// The movable holidays follow the Orthodox Easter whatever the EasterMethod of
+// the calendar. Holidays falling on a weekend are not made up on a work day.
+var (
+	RO_AnulNou                 = observedExact(named(US_NewYear, "Anul Nou"))
+	RO_AnulNou2                = observedExact(NewNamedHoliday("Anul Nou", time.January, 2))
+	RO_Boboteaza               = observedExact(validFrom(named(catholicEpiphany, "Bobotează"), 2024))
+	RO_SfantulIoan             = observedExact(validFrom(NewNamedHoliday("Sfântul Ioan Botezătorul", time.January, 7), 2024))
+	RO_ZiuaUnirii              = observedExact(validFrom(NewNamedHoliday("Ziua Unirii Principatelor Române", time.January, 24), 2017))
+	RO_VinereaMare             = observedExact(validFrom(named(NewHolidayOrthodoxEasterOffset(-2), "Vinerea Mare"), 2018))
+	RO_Pastele                 = observedExact(named(NewHolidayOrthodoxEasterOffset(0), "Paștele"))
+	RO_Pastele2                = observedExact(named(NewHolidayOrthodoxEasterOffset(1), "Paștele"))
+	RO_ZiuaMuncii              = observedExact(named(ECB_LabourDay, "Ziua Muncii"))
+	RO_ZiuaCopilului           = observedExact(validFrom(NewNamedHoliday("Ziua Copilului", time.June, 1), 2017))
+	RO_Rusaliile               = observedExact(named(NewHolidayOrthodoxEasterOffset(49), "Rusaliile"))
+	RO_Rusaliile2              = observedExact(named(NewHolidayOrthodoxEasterOffset(50), "Rusaliile"))
+	RO_AdormireaMaiciiDomnului = observedExact(named(catholicAssumption, "Adormirea Maicii Domnului"))
+	RO_SfantulAndrei           = observedExact(validFrom(NewNamedHoliday("Sfântul Andrei", time.November, 30), 2012))
+	RO_ZiuaNationala           = observedExact(NewNamedHoliday("Ziua Națională a României", time.December, 1))
+	RO_Craciunul               = observedExact(named(ECB_ChristmasDay, "Crăciunul"))
+	RO_Craciunul2              = observedExact(named(ECB_ChristmasHoliday, "Crăciunul"))
+)
+
+var romanianHolidays = []Holiday{
+	RO_AnulNou,
+	RO_AnulNou2,
+	RO_Boboteaza,
+	RO_SfantulIoan,
+	RO_ZiuaUnirii,
+	RO_VinereaMare,
+	RO_Pastele,
+	RO_Pastele2,
+	RO_ZiuaMuncii,
+	RO_ZiuaCopilului,
+	RO_Rusaliile,
+	RO_Rusaliile2,
+	RO_AdormireaMaiciiDomnului,
+	RO_SfantulAndrei,
+	RO_ZiuaNationala,
+	RO_Craciunul,
+	RO_Craciunul2,
+}
+
+// AddRomanianHolidays adds all Romanian holidays to Calendar
+func AddRomanianHolidays(c *Calendar) {
+	c.AddHolidays(romanianHolidays...)
+}
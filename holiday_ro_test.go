@@ -0,0 +1,59 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestRomanianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("RO")...)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 1, 1):   "Anul Nou",
+		date(2024, 1, 2):   "Anul Nou",
+		date(2024, 1, 6):   "Bobotează",
+		date(2024, 1, 7):   "Sfântul Ioan Botezătorul",
+		date(2024, 1, 24):  "Ziua Unirii Principatelor Române",
+		date(2024, 5, 1):   "Ziua Muncii",
+		date(2024, 5, 3):   "Vinerea Mare",
+		date(2024, 5, 5):   "Paștele",
+		date(2024, 5, 6):   "Paștele",
+		date(2024, 6, 1):   "Ziua Copilului",
+		date(2024, 6, 23):  "Rusaliile",
+		date(2024, 6, 24):  "Rusaliile",
+		date(2024, 8, 15):  "Adormirea Maicii Domnului",
+		date(2024, 11, 30): "Sfântul Andrei",
+		date(2024, 12, 1):  "Ziua Națională a României",
+		date(2024, 12, 25): "Crăciunul",
+		date(2024, 12, 26): "Crăciunul",
+	})
+}
+
+func TestRomanianWorkdays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("RO")...)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 5, 6), false},  // second day of Paștele
+		{date(2024, 5, 7), true},   // Paștele on Sunday is not moved
+		{date(2024, 6, 24), false}, // second day of Rusaliile
+		{date(2024, 6, 25), true},  // Rusaliile on Sunday is not moved
+		{date(2024, 5, 31), true},  // Ziua Copilului on Saturday
+		{date(2024, 11, 29), true}, // Sfântul Andrei on Saturday
+		{date(2024, 12, 2), true},  // Ziua Națională on Sunday
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
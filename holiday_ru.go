@@ -0,0 +1,107 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Russia
+//
+// A holiday falling on a weekend is moved to the next work day, except for
+// the New Year holidays in January. Each year the government also decrees
+// transfers of days off to join holidays into longer breaks; in the years
+// with a known decree, its transfers replace the moves of weekend holidays.
+var (
+	RU_NewYearHolidays  = NewHolidayObserved(named(NewHolidayRange(time.January, 1, time.January, 6), "Новогодние каникулы"), ObservedExact)
+	RU_Christmas        = NewHolidayObserved(NewNamedHoliday("Рождество Христово", time.January, 7), ObservedExact)
+	RU_NewYearHolidays8 = NewHolidayObserved(NewNamedHoliday("Новогодние каникулы", time.January, 8), ObservedExact)
+	RU_DefenderDay      = russianHoliday(NewNamedHoliday("День защитника Отечества", time.February, 23))
+	RU_WomensDay        = russianHoliday(NewNamedHoliday("Международный женский день", time.March, 8))
+	RU_LabourDay        = russianHoliday(named(ECB_LabourDay, "Праздник Весны и Труда"))
+	RU_VictoryDay       = russianHoliday(NewNamedHoliday("День Победы", time.May, 9))
+	RU_RussiaDay        = russianHoliday(NewNamedHoliday("День России", time.June, 12))
+	RU_UnityDay         = russianHoliday(NewNamedHoliday("День народного единства", time.November, 4))
+)
+
+var russianHolidays = []Holiday{
+	RU_NewYearHolidays,
+	RU_Christmas,
+	RU_NewYearHolidays8,
+	RU_DefenderDay,
+	RU_WomensDay,
+	RU_LabourDay,
+	RU_VictoryDay,
+	RU_RussiaDay,
+	RU_UnityDay,
+}
+
+// russianDecrees holds the transfers of days off decreed for each year,
+// including the moves of weekend holidays.
+var russianDecrees = map[int][]DayTransfer{
+	2023: {
+		{From: ymd(2023, time.January, 1), To: ymd(2023, time.February, 24)},
+		{From: ymd(2023, time.January, 8), To: ymd(2023, time.May, 8)},
+		{From: ymd(2023, time.November, 4), To: ymd(2023, time.November, 6)},
+	},
+	2024: {
+		{From: ymd(2024, time.January, 6), To: ymd(2024, time.May, 10)},
+		{From: ymd(2024, time.January, 7), To: ymd(2024, time.December, 31)},
+		{From: ymd(2024, time.April, 27), To: ymd(2024, time.April, 29)},
+		{From: ymd(2024, time.November, 2), To: ymd(2024, time.April, 30)},
+		{From: ymd(2024, time.December, 28), To: ymd(2024, time.December, 30)},
+	},
+}
+
+// russianTransferName is the name of the days off transferred by a decree.
+const russianTransferName = "Перенесённый выходной день"
+
+// ymd is a shorthand for a date in UTC.
+func ymd(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// russianHoliday returns a copy of the holiday observed according to the
+// Russian rules.
+func russianHoliday(h Holiday) Holiday {
+	h.ObservedFunc = observedRussian
+	return h
+}
+
+// observedRussian moves a weekend holiday to the next work day unless the
+// calendar has a decree for the year, whose transfers then decide the days
+// off.
+func observedRussian(date time.Time, c *Calendar) time.Time {
+	if hasRussianDecree(c, date.Year()) {
+		return date
+	}
+	return c.observedTarget(ObservedMonday, date)
+}
+
+// hasRussianDecree reports whether the calendar has days off transferred by a
+// decree in the given year.
+func hasRussianDecree(c *Calendar, year int) bool {
+	for idx := range c.holidays {
+		for _, h := range c.holidays[idx] {
+			if h.Name == russianTransferName && h.ValidFrom == year && h.ValidTo == year {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AddRussianDecree adds the transfers of days off of a decree to Calendar,
+// such as one published after this package. Weekend holidays in the years of
+// the days taken off are no longer moved, as the decree decides all days off
+// of its year.
+func AddRussianDecree(c *Calendar, transfers ...DayTransfer) {
+	c.AddDayTransfers(russianTransferName, transfers...)
+}
+
+// AddRussianHolidays adds all Russian holidays to Calendar, along with the
+// transfers of days off of the known decrees
+func AddRussianHolidays(c *Calendar) {
+	c.AddHolidays(russianHolidays...)
+	for _, ts := range russianDecrees {
+		AddRussianDecree(c, ts...)
+	}
+}
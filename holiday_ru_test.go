@@ -0,0 +1,88 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+)
+
+func TestRussianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddRussianHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2023, 1, 6), false},   // Новогодние каникулы
+		{date(2023, 1, 9), true},    // no move of the January holidays
+		{date(2023, 2, 23), false},  // День защитника Отечества
+		{date(2023, 2, 24), false},  // transferred from January 1st
+		{date(2023, 5, 8), false},   // transferred from January 8th
+		{date(2023, 11, 6), false},  // День народного единства moved
+		{date(2024, 4, 27), true},   // Saturday worked
+		{date(2024, 4, 29), false},  // transferred from April 27th
+		{date(2024, 4, 30), false},  // transferred from November 2nd
+		{date(2024, 11, 2), true},   // Saturday worked
+		{date(2024, 12, 28), true},  // Saturday worked
+		{date(2024, 12, 30), false}, // transferred from December 28th
+		{date(2024, 12, 31), false}, // transferred from January 7th
+		{date(2024, 5, 10), false},  // transferred from January 6th
+		{date(2024, 5, 13), true},
+		{date(2021, 6, 14), false}, // День России moved without a decree
+		{date(2021, 6, 15), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestAddRussianDecree(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddRussianHolidays(c)
+	other := cal.NewCalendar()
+	cal.AddRussianHolidays(other)
+
+	cal.AddRussianDecree(c, cal.DayTransfer{From: date(2022, 3, 5), To: date(2022, 3, 7)})
+
+	tests := []struct {
+		c    *cal.Calendar
+		t    time.Time
+		want bool
+	}{
+		{c, date(2022, 3, 5), true},  // Saturday worked
+		{c, date(2022, 3, 7), false}, // transferred from March 5th
+		{c, date(2022, 6, 13), true}, // the decree decides the days off
+		{other, date(2022, 3, 5), false},
+		{other, date(2022, 3, 7), true},
+		{other, date(2022, 6, 13), false}, // День России moved without a decree
+	}
+
+	for _, test := range tests {
+		got := test.c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestAddDayTransfers(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddUSHolidays(c)
+	c.AddDayTransfers("Bridge day", cal.DayTransfer{From: date(2021, 11, 20), To: date(2021, 11, 26)})
+
+	if !c.IsWorkday(date(2021, 11, 20)) {
+		t.Errorf("Expected the Saturday given up to be a workday")
+	}
+	if c.IsWorkday(date(2021, 11, 26)) {
+		t.Errorf("Expected the day taken off to be a holiday")
+	}
+	if name, _ := c.HolidayName(date(2021, 11, 26)); name != "Bridge day" {
+		t.Errorf("got: %q; want: %q", name, "Bridge day")
+	}
+}
@@ -0,0 +1,52 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Saudi Arabia
+//
+// The Eid holidays follow the Islamic calendar. Government decrees often add
+// days to them, which is not reflected.
+var (
+	SA_FoundingDay = validFrom(NewNamedHoliday("يوم التأسيس", time.February, 22), 2022)
+	SA_EidAlFitr1  = named(NewHolidayHijri(10, 1), "عيد الفطر")
+	SA_EidAlFitr2  = named(NewHolidayHijri(10, 2), "عيد الفطر")
+	SA_EidAlFitr3  = named(NewHolidayHijri(10, 3), "عيد الفطر")
+	SA_ArafatDay   = named(NewHolidayHijri(12, 9), "يوم عرفة")
+	SA_EidAlAdha1  = named(NewHolidayHijri(12, 10), "عيد الأضحى")
+	SA_EidAlAdha2  = named(NewHolidayHijri(12, 11), "عيد الأضحى")
+	SA_EidAlAdha3  = named(NewHolidayHijri(12, 12), "عيد الأضحى")
+	SA_NationalDay = validFrom(NewNamedHoliday("اليوم الوطني", time.September, 23), 2005)
+)
+
+var saudiHolidays = []Holiday{
+	SA_FoundingDay,
+	SA_EidAlFitr1,
+	SA_EidAlFitr2,
+	SA_EidAlFitr3,
+	SA_ArafatDay,
+	SA_EidAlAdha1,
+	SA_EidAlAdha2,
+	SA_EidAlAdha3,
+	SA_NationalDay,
+}
+
+// AddSaudiHolidays adds all Saudi holidays to Calendar
+func AddSaudiHolidays(c *Calendar) {
+	c.AddHolidays(saudiHolidays...)
+}
+
+// NewSaudiCalendar creates a new Calendar with the Saudi holidays and the work
+// week in effect at each date: Saturday to Wednesday until June 2013 and
+// Sunday to Thursday since. Holidays are not moved when they fall on a
+// weekend.
+func NewSaudiCalendar() *Calendar {
+	c := NewCalendar()
+	c.SetWeekmask([7]bool{true, true, true, true, false, false, true})
+	c.SetWeekmaskFrom(time.Date(2013, time.June, 29, 0, 0, 0, 0, time.UTC),
+		[7]bool{true, true, true, true, true, false, false})
+	c.Observed = ObservedExact
+	AddSaudiHolidays(c)
+	return c
+}
@@ -0,0 +1,50 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestSaudiHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("SA")...)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 2, 22): "يوم التأسيس",
+		date(2024, 4, 10): "عيد الفطر",
+		date(2024, 4, 11): "عيد الفطر",
+		date(2024, 4, 12): "عيد الفطر",
+		date(2024, 6, 15): "يوم عرفة",
+		date(2024, 6, 16): "عيد الأضحى",
+		date(2024, 6, 17): "عيد الأضحى",
+		date(2024, 6, 18): "عيد الأضحى",
+		date(2024, 9, 23): "اليوم الوطني",
+	})
+}
+
+func TestSaudiWorkweek(t *testing.T) {
+	c := cal.NewSaudiCalendar()
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2013, 6, 27), false}, // Thursday
+		{date(2013, 6, 28), false}, // Friday
+		{date(2013, 6, 29), false}, // Saturday of the new weekend
+		{date(2013, 6, 30), true},  // Sunday
+		{date(2013, 7, 4), true},   // Thursday
+		{date(2013, 7, 6), false},  // Saturday
+		{date(2024, 9, 23), false}, // National Day
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
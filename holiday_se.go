@@ -0,0 +1,53 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Sweden
+//
+// Midsommarafton, Julafton and Nyårsafton are not public holidays by law, but
+// are treated as such by most employers. No holiday is moved off a weekend,
+// whatever the ObservedRule of the calendar.
+var (
+	SE_Nyarsdagen        = observedExact(named(US_NewYear, "Nyårsdagen"))
+	SE_TrettondedagJul   = observedExact(named(catholicEpiphany, "Trettondedag jul"))
+	SE_Langfredagen      = observedExact(named(ECB_GoodFriday, "Långfredagen"))
+	SE_Paskdagen         = observedExact(named(NewHolidayEasterOffset(0), "Påskdagen"))
+	SE_AnnandagPask      = observedExact(named(ECB_EasterMonday, "Annandag påsk"))
+	SE_ForstaMaj         = observedExact(named(ECB_LabourDay, "Första maj"))
+	SE_KristiHimmelsfard = observedExact(named(catholicAscension, "Kristi himmelsfärdsdag"))
+	SE_Nationaldagen     = observedExact(validFrom(NewNamedHoliday("Sveriges nationaldag", time.June, 6), 2005))
+	SE_Pingstdagen       = observedExact(named(NewHolidayEasterOffset(49), "Pingstdagen"))
+	SE_Midsommarafton    = observedExact(named(NewHolidayWeekdayInRange(time.Friday, time.June, 19, time.June, 25), "Midsommarafton"))
+	SE_Midsommardagen    = observedExact(named(NewHolidayWeekdayInRange(time.Saturday, time.June, 20, time.June, 26), "Midsommardagen"))
+	SE_AllaHelgonsDag    = observedExact(named(NewHolidayWeekdayInRange(time.Saturday, time.October, 31, time.November, 6), "Alla helgons dag"))
+	SE_Julafton          = observedExact(NewNamedHoliday("Julafton", time.December, 24))
+	SE_Juldagen          = observedExact(named(ECB_ChristmasDay, "Juldagen"))
+	SE_AnnandagJul       = observedExact(named(ECB_ChristmasHoliday, "Annandag jul"))
+	SE_Nyarsafton        = observedExact(NewNamedHoliday("Nyårsafton", time.December, 31))
+)
+
+var swedishHolidays = []Holiday{
+	SE_Nyarsdagen,
+	SE_TrettondedagJul,
+	SE_Langfredagen,
+	SE_Paskdagen,
+	SE_AnnandagPask,
+	SE_ForstaMaj,
+	SE_KristiHimmelsfard,
+	SE_Nationaldagen,
+	SE_Pingstdagen,
+	SE_Midsommarafton,
+	SE_Midsommardagen,
+	SE_AllaHelgonsDag,
+	SE_Julafton,
+	SE_Juldagen,
+	SE_AnnandagJul,
+	SE_Nyarsafton,
+}
+
+// AddSwedishHolidays adds all Swedish holidays to Calendar
+func AddSwedishHolidays(c *Calendar) {
+	c.AddHolidays(swedishHolidays...)
+}
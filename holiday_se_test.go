@@ -0,0 +1,72 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestSwedishHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddSwedishHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "Nyårsdagen",
+		date(2023, 1, 6):   "Trettondedag jul",
+		date(2023, 4, 7):   "Långfredagen",
+		date(2023, 4, 9):   "Påskdagen",
+		date(2023, 4, 10):  "Annandag påsk",
+		date(2023, 5, 1):   "Första maj",
+		date(2023, 5, 18):  "Kristi himmelsfärdsdag",
+		date(2023, 5, 28):  "Pingstdagen",
+		date(2023, 6, 6):   "Sveriges nationaldag",
+		date(2023, 6, 23):  "Midsommarafton",
+		date(2023, 6, 24):  "Midsommardagen",
+		date(2023, 11, 4):  "Alla helgons dag",
+		date(2023, 12, 24): "Julafton",
+		date(2023, 12, 25): "Juldagen",
+		date(2023, 12, 26): "Annandag jul",
+		date(2023, 12, 31): "Nyårsafton",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2020, 6, 19), true},  // Midsommarafton on the first day of the range
+		{date(2020, 6, 20), true},  // Midsommardagen
+		{date(2020, 10, 31), true}, // Alla helgons dag in October
+		{date(2021, 11, 6), true},  // Alla helgons dag on the last day of the range
+		{date(2021, 10, 30), false},
+		{date(2004, 6, 6), false}, // before Sveriges nationaldag
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	workdays := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 11, 1), true}, // Friday before Alla helgons dag
+		{date(2024, 4, 2), true},  // Tuesday after Påskdagen and Annandag påsk
+		{date(2024, 5, 21), true}, // Tuesday after Pingstdagen
+		{date(2024, 6, 20), true}, // Thursday before Midsommarafton
+		{date(2024, 6, 21), false},
+		{date(2022, 12, 23), true}, // Friday before Julafton
+		{date(2021, 6, 7), true},   // Monday after Sveriges nationaldag
+	}
+
+	for _, test := range workdays {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
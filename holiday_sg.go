@@ -0,0 +1,93 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Singapore
+//
+// The festivals other than Chinese New Year follow the dates gazetted by the
+// Ministry of Manpower for the years in singaporeFestivals. A holiday that
+// falls on a Sunday is observed on the next day that is not a holiday.
+var (
+	SG_NewYear         = sgHoliday(US_NewYear)
+	SG_ChineseNewYear  = sgHoliday(Holiday{Name: "Chinese New Year", FuncOK: calculateLunarNewYear})
+	SG_ChineseNewYear2 = sgHoliday(named(NewHolidayRelative(SG_ChineseNewYear, 1), "Chinese New Year"))
+	SG_HariRayaPuasa   = sgHoliday(Holiday{Name: "Hari Raya Puasa", FuncOK: calculateHariRayaPuasa})
+	SG_GoodFriday      = sgHoliday(ECB_GoodFriday)
+	SG_LabourDay       = sgHoliday(named(ECB_LabourDay, "Labour Day"))
+	SG_VesakDay        = sgHoliday(Holiday{Name: "Vesak Day", FuncOK: calculateVesak})
+	SG_HariRayaHaji    = sgHoliday(Holiday{Name: "Hari Raya Haji", FuncOK: calculateHariRayaHaji})
+	SG_NationalDay     = sgHoliday(validFrom(NewNamedHoliday("National Day", time.August, 9), 1966))
+	SG_Deepavali       = sgHoliday(Holiday{Name: "Deepavali", FuncOK: calculateDeepavali})
+	SG_ChristmasDay    = sgHoliday(ECB_ChristmasDay)
+)
+
+var singaporeanHolidays = []Holiday{
+	SG_NewYear,
+	SG_ChineseNewYear,
+	SG_ChineseNewYear2,
+	SG_HariRayaPuasa,
+	SG_GoodFriday,
+	SG_LabourDay,
+	SG_VesakDay,
+	SG_HariRayaHaji,
+	SG_NationalDay,
+	SG_Deepavali,
+	SG_ChristmasDay,
+}
+
+// sgHoliday returns a copy of the holiday that is moved from a Sunday to the
+// Monday.
+func sgHoliday(h Holiday) Holiday {
+	return NewHolidayObserved(h, ObservedSundayToMonday)
+}
+
+// singaporeFestival holds the gazetted dates of the festivals of a year.
+type singaporeFestival struct {
+	hariRayaPuasa monthDay
+	vesak         monthDay
+	hariRayaHaji  monthDay
+	deepavali     monthDay
+}
+
+// singaporeFestivals holds the festivals announced by the Ministry of
+// Manpower for 2020 through 2026, usually a year ahead.
+var singaporeFestivals = map[int]singaporeFestival{
+	2020: {monthDay{time.May, 24}, monthDay{time.May, 7}, monthDay{time.July, 31}, monthDay{time.November, 14}},
+	2021: {monthDay{time.May, 13}, monthDay{time.May, 26}, monthDay{time.July, 20}, monthDay{time.November, 4}},
+	2022: {monthDay{time.May, 3}, monthDay{time.May, 15}, monthDay{time.July, 10}, monthDay{time.October, 24}},
+	2023: {monthDay{time.April, 22}, monthDay{time.June, 2}, monthDay{time.June, 29}, monthDay{time.November, 12}},
+	2024: {monthDay{time.April, 10}, monthDay{time.May, 22}, monthDay{time.June, 17}, monthDay{time.October, 31}},
+	2025: {monthDay{time.March, 31}, monthDay{time.May, 12}, monthDay{time.June, 7}, monthDay{time.October, 20}},
+	2026: {monthDay{time.March, 21}, monthDay{time.May, 31}, monthDay{time.May, 27}, monthDay{time.November, 8}},
+}
+
+// Hari Raya Puasa is 1 Syawal, at the end of Ramadan.
+func calculateHariRayaPuasa(year int, loc *time.Location) (time.Month, int, bool) {
+	f, ok := singaporeFestivals[year]
+	return f.hariRayaPuasa.month, f.hariRayaPuasa.day, ok
+}
+
+// Vesak Day is the full moon of the month of Vesakha.
+func calculateVesak(year int, loc *time.Location) (time.Month, int, bool) {
+	f, ok := singaporeFestivals[year]
+	return f.vesak.month, f.vesak.day, ok
+}
+
+// Hari Raya Haji is 10 Zulhijjah.
+func calculateHariRayaHaji(year int, loc *time.Location) (time.Month, int, bool) {
+	f, ok := singaporeFestivals[year]
+	return f.hariRayaHaji.month, f.hariRayaHaji.day, ok
+}
+
+// Deepavali is the new moon of the month of Aipasi in the Tamil calendar.
+func calculateDeepavali(year int, loc *time.Location) (time.Month, int, bool) {
+	f, ok := singaporeFestivals[year]
+	return f.deepavali.month, f.deepavali.day, ok
+}
+
+// AddSingaporeanHolidays adds all Singaporean holidays to Calendar
+func AddSingaporeanHolidays(c *Calendar) {
+	c.AddHolidays(singaporeanHolidays...)
+}
@@ -0,0 +1,53 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestSingaporeanHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("SG")...)
+
+	caltest.AssertHolidays(t, c, 2026, map[time.Time]string{
+		date(2026, 1, 1):   "New Year's Day",
+		date(2026, 2, 17):  "Chinese New Year",
+		date(2026, 2, 18):  "Chinese New Year",
+		date(2026, 3, 21):  "Hari Raya Puasa",
+		date(2026, 4, 3):   "Good Friday",
+		date(2026, 5, 1):   "Labour Day",
+		date(2026, 5, 27):  "Hari Raya Haji",
+		date(2026, 5, 31):  "Vesak Day",
+		date(2026, 8, 9):   "National Day",
+		date(2026, 11, 8):  "Deepavali",
+		date(2026, 12, 25): "Christmas Day",
+	})
+}
+
+func TestSingaporeanObserved(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddSingaporeanHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2023, 1, 23), false}, // Chinese New Year
+		{date(2023, 1, 24), false}, // Chinese New Year on Sunday
+		{date(2023, 1, 25), true},
+		{date(2026, 6, 1), false},  // Vesak Day on Sunday
+		{date(2026, 8, 10), false}, // National Day on Sunday
+		{date(2026, 3, 23), true},  // Hari Raya Puasa on Saturday is not moved
+		{date(2019, 10, 28), true}, // before the festival table
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
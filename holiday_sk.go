@@ -0,0 +1,50 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Slovakia
+//
+// Slovak law has no substitute day for a holiday on a Saturday or Sunday.
+var (
+	SK_DenVznikuRepubliky   = observedExact(named(US_NewYear, "Deň vzniku Slovenskej republiky"))
+	SK_ZjaveniePana         = observedExact(named(catholicEpiphany, "Zjavenie Pána"))
+	SK_VelkyPiatok          = observedExact(named(ECB_GoodFriday, "Veľký piatok"))
+	SK_VelkonocnyPondelok   = observedExact(named(ECB_EasterMonday, "Veľkonočný pondelok"))
+	SK_SviatokPrace         = observedExact(named(ECB_LabourDay, "Sviatok práce"))
+	SK_DenVitazstva         = observedExact(NewNamedHoliday("Deň víťazstva nad fašizmom", time.May, 8))
+	SK_CyrilAMetod          = observedExact(NewNamedHoliday("Sviatok svätého Cyrila a Metoda", time.July, 5))
+	SK_SNP                  = observedExact(NewNamedHoliday("Výročie Slovenského národného povstania", time.August, 29))
+	SK_DenUstavy            = observedExact(validTo(NewNamedHoliday("Deň Ústavy Slovenskej republiky", time.September, 1), 2023))
+	SK_SedembolestnaPanna   = observedExact(NewNamedHoliday("Sedembolestná Panna Mária", time.September, 15))
+	SK_VsetkychSvatych      = observedExact(named(catholicAllSaints, "Sviatok Všetkých svätých"))
+	SK_DenBojaZaSlobodu     = observedExact(NewNamedHoliday("Deň boja za slobodu a demokraciu", time.November, 17))
+	SK_StedryDen            = observedExact(NewNamedHoliday("Štedrý deň", time.December, 24))
+	SK_PrvySviatokVianocny  = observedExact(named(ECB_ChristmasDay, "Prvý sviatok vianočný"))
+	SK_DruhySviatokVianocny = observedExact(named(ECB_ChristmasHoliday, "Druhý sviatok vianočný"))
+)
+
+var slovakHolidays = []Holiday{
+	SK_DenVznikuRepubliky,
+	SK_ZjaveniePana,
+	SK_VelkyPiatok,
+	SK_VelkonocnyPondelok,
+	SK_SviatokPrace,
+	SK_DenVitazstva,
+	SK_CyrilAMetod,
+	SK_SNP,
+	SK_DenUstavy,
+	SK_SedembolestnaPanna,
+	SK_VsetkychSvatych,
+	SK_DenBojaZaSlobodu,
+	SK_StedryDen,
+	SK_PrvySviatokVianocny,
+	SK_DruhySviatokVianocny,
+}
+
+// AddSlovakHolidays adds all Slovak holidays to Calendar. Constitution Day is
+// included until 2023, after which it is no longer a day off.
+func AddSlovakHolidays(c *Calendar) {
+	c.AddHolidays(slovakHolidays...)
+}
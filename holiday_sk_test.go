@@ -0,0 +1,57 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestSlovakHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddSlovakHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "Deň vzniku Slovenskej republiky",
+		date(2023, 1, 6):   "Zjavenie Pána",
+		date(2023, 4, 7):   "Veľký piatok",
+		date(2023, 4, 10):  "Veľkonočný pondelok",
+		date(2023, 5, 1):   "Sviatok práce",
+		date(2023, 5, 8):   "Deň víťazstva nad fašizmom",
+		date(2023, 7, 5):   "Sviatok svätého Cyrila a Metoda",
+		date(2023, 8, 29):  "Výročie Slovenského národného povstania",
+		date(2023, 9, 1):   "Deň Ústavy Slovenskej republiky",
+		date(2023, 9, 15):  "Sedembolestná Panna Mária",
+		date(2023, 11, 1):  "Sviatok Všetkých svätých",
+		date(2023, 11, 17): "Deň boja za slobodu a demokraciu",
+		date(2023, 12, 24): "Štedrý deň",
+		date(2023, 12, 25): "Prvý sviatok vianočný",
+		date(2023, 12, 26): "Druhý sviatok vianočný",
+	})
+
+	if c.IsHoliday(date(2024, 9, 1)) {
+		t.Errorf("Did not expect Deň Ústavy after 2023")
+	}
+}
+
+func TestSlovakWorkdays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddSlovakHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2020, 8, 28), true}, // Friday before Výročie Slovenského národného povstania
+		{date(2021, 8, 30), true}, // Monday after Výročie Slovenského národného povstania
+		{date(2019, 9, 16), true}, // Monday after Sedembolestná Panna Mária
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -61,3 +61,761 @@ func TestAddBritishHolidays(t *testing.T) {
 		})
 	}
 }
+
+func TestElectionDay(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(US_Election)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2016, 11, 1, 12, 0, 0, 0, time.UTC), false}, // November 1st is a Tuesday
+		{time.Date(2016, 11, 8, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2020, 11, 3, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC), false}, // November 1st is a Tuesday
+		{time.Date(2022, 11, 8, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 11, 5, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 11, 12, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestUSFederalObservances(t *testing.T) {
+	c := NewCalendar()
+	c.Observed = ObservedExact
+	AddUSFederalObservances(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2020, 11, 3, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2021, 1, 20, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2021, 11, 2, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2022, 11, 8, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, 1, 20, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2023, 11, 7, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 11, 5, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2013, 1, 20, 12, 0, 0, 0, time.UTC), false}, // a Sunday
+		{time.Date(2013, 1, 21, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	for year := 2000; year <= 2040; year++ {
+		n := 0
+		for date := time.Date(year, 11, 1, 12, 0, 0, 0, time.UTC); date.Month() == time.November; date = date.AddDate(0, 0, 1) {
+			if c.IsHoliday(date) {
+				if date.Day() == 1 {
+					t.Errorf("Election Day on November 1st (%d)", year)
+				}
+				n++
+			}
+		}
+		if want := 1 - year%2; n != want {
+			t.Errorf("got: %d; want: %d election days (%d)", n, want, year)
+		}
+		if got, want := c.HolidayCount(year) > n, year%4 == 1; got != want {
+			t.Errorf("got: %t; want: %t inauguration (%d)", got, want, year)
+		}
+	}
+}
+
+func TestVictoriaDay(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(CA_VictoriaDay)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2017, 5, 22, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2020, 5, 18, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2020, 5, 25, 12, 0, 0, 0, time.UTC), false}, // May 25th is never Victoria Day
+		{time.Date(2021, 5, 24, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2021, 5, 17, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestRegionCalendars(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        *Calendar
+		observed ObservedRule
+		t        time.Time
+	}{
+		{"US", NewUSCalendar(), ObservedNearest, time.Date(2017, 11, 23, 12, 0, 0, 0, time.UTC)},
+		{"ECB", NewECBCalendar(), ObservedExact, time.Date(2017, 5, 1, 12, 0, 0, 0, time.UTC)},
+		{"DE", NewGermanCalendar(), ObservedExact, time.Date(2017, 10, 3, 12, 0, 0, 0, time.UTC)},
+		{"NL", NewDutchCalendar(), ObservedExact, time.Date(2017, 4, 27, 12, 0, 0, 0, time.UTC)},
+		{"GB", NewBritishCalendar(), ObservedMonday, time.Date(2017, 8, 28, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.c.Observed != test.observed {
+				t.Errorf("got observed rule: %d; want: %d", test.c.Observed, test.observed)
+			}
+			if !test.c.IsHoliday(test.t) {
+				t.Errorf("Expected %q to be a holiday but wasn't", test.t)
+			}
+		})
+	}
+}
+
+func TestHolidayFuncOK(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(NewHolidayFuncOK(func(year int, loc *time.Location) (time.Month, int, bool) {
+		return time.June, 1, year%2 == 0
+	}))
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2016, 6, 1, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2018, 6, 2, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func countHolidays(c *Calendar) int {
+	n := 0
+	for i := range c.holidays {
+		n += len(c.holidays[i])
+	}
+	return n
+}
+
+func TestAddHolidayDuplicates(t *testing.T) {
+	c := NewCalendar()
+	AddGermanHolidays(c)
+	want := countHolidays(c)
+	AddGermanHolidays(c)
+	if got := countHolidays(c); got != want {
+		t.Errorf("got: %d holidays; want: %d", got, want)
+	}
+
+	// same date under a different name is a separate holiday
+	h := US_Independence
+	h.Name = "Company Picnic"
+	c.AddHoliday(US_Independence)
+	c.AddHoliday(h)
+	if got := countHolidays(c); got != want+2 {
+		t.Errorf("got: %d holidays; want: %d", got, want+2)
+	}
+}
+
+func TestHolidayEqual(t *testing.T) {
+	tests := []struct {
+		a    Holiday
+		b    Holiday
+		want bool
+	}{
+		{US_Christmas, ECB_ChristmasDay, true},
+		{US_Christmas, US_Independence, false},
+		{US_Labor, US_Labor, true},
+		{US_Labor, US_Memorial, false},
+		{ECB_GoodFriday, Holiday{Name: "Good Friday", Easter: true, Offset: -2}, true},
+		{ECB_GoodFriday, DE_KarFreitag, false},
+		{ECB_GoodFriday, ECB_EasterMonday, false},
+		{ECB_GoodFriday, Holiday{Month: time.April, Day: 14}, false},
+		{Holiday{Month: time.May, Day: 9, ValidFrom: 2019}, NewHoliday(time.May, 9), false},
+		{Holiday{Name: "A", Month: time.May, Day: 9}, Holiday{Name: "B", Month: time.May, Day: 9}, false},
+	}
+
+	for i, test := range tests {
+		got := test.a.Equal(test.b)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%d)", got, test.want, i)
+		}
+	}
+}
+
+func TestHolidayRelative(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(NewHolidayRelative(US_Thanksgiving, 1))
+	c.AddHoliday(NewHolidayRelative(US_NewYear, -1))
+	c.AddHoliday(NewHolidayRelative(ECB_GoodFriday, -1))
+	c.AddHoliday(NewHolidayRelative(DE_Himmelfahrt, 1))
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2016, 11, 25, 12, 0, 0, 0, time.UTC), true}, // Black Friday
+		{time.Date(2017, 11, 24, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2018, 11, 23, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2018, 11, 22, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2016, 12, 31, 12, 0, 0, 0, time.UTC), true}, // New Year's Eve
+		{time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2016, 3, 24, 12, 0, 0, 0, time.UTC), true}, // Maundy Thursday
+		{time.Date(2017, 4, 13, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2016, 5, 6, 12, 0, 0, 0, time.UTC), true}, // bridge day after Ascension
+		{time.Date(2017, 5, 26, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 5, 25, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestHolidayFloatClamp(t *testing.T) {
+	fifth := NewHolidayFloat(time.June, time.Monday, 5)
+	fifthClamped := fifth
+	fifthClamped.Clamp = true
+	lastFifth := NewHolidayFloat(time.June, time.Monday, -5)
+	lastFifth.Clamp = true
+
+	tests := []struct {
+		h    Holiday
+		t    time.Time
+		want bool
+	}{
+		// June 2016 has only four Mondays
+		{fifth, time.Date(2016, 6, 27, 12, 0, 0, 0, time.UTC), false},
+		{fifthClamped, time.Date(2016, 6, 27, 12, 0, 0, 0, time.UTC), true},
+		{fifthClamped, time.Date(2016, 6, 20, 12, 0, 0, 0, time.UTC), false},
+		{lastFifth, time.Date(2016, 6, 6, 12, 0, 0, 0, time.UTC), true},
+		{lastFifth, time.Date(2016, 6, 13, 12, 0, 0, 0, time.UTC), false},
+		// June 2015 has five Mondays
+		{fifth, time.Date(2015, 6, 29, 12, 0, 0, 0, time.UTC), true},
+		{fifthClamped, time.Date(2015, 6, 29, 12, 0, 0, 0, time.UTC), true},
+		{fifthClamped, time.Date(2015, 6, 22, 12, 0, 0, 0, time.UTC), false},
+		{lastFifth, time.Date(2015, 6, 1, 12, 0, 0, 0, time.UTC), true},
+		// Sundays are valid weekdays too
+		{NewHolidayFloat(time.May, time.Sunday, 2), time.Date(2016, 5, 8, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		c := NewCalendar()
+		c.AddHoliday(test.h)
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s, %s)", got, test.want, test.h, test.t)
+		}
+	}
+}
+
+func TestRegions(t *testing.T) {
+	codes := Regions()
+	for _, want := range []string{"DE", "GB", "NL", "US"} {
+		found := false
+		for _, code := range codes {
+			if code == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected region %q in %v", want, codes)
+		}
+	}
+
+	if got := len(HolidaysForRegion("US")); got != 11 {
+		t.Errorf("got: %d US holidays; want: 11", got)
+	}
+	if got := HolidaysForRegion("XX"); got != nil {
+		t.Errorf("got: %v; want: nil", got)
+	}
+
+	c := NewCalendar()
+	if err := AddHolidaysForCountry(c, "US"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got := countHolidays(c); got != 11 {
+		t.Errorf("got: %d holidays; want: 11", got)
+	}
+	if err := AddHolidaysForCountry(c, "XX"); err == nil {
+		t.Errorf("Expected an error for an unknown region")
+	}
+}
+
+func TestHolidayNext(t *testing.T) {
+	tz, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("unable to load time zone: %v", err)
+	}
+	jst := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		h    Holiday
+		t    time.Time
+		loc  *time.Location
+		want time.Time
+	}{
+		{US_Thanksgiving, time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC), nil, time.Date(2017, 11, 23, 0, 0, 0, 0, time.UTC)},
+		{US_Thanksgiving, time.Date(2017, 11, 23, 15, 0, 0, 0, time.UTC), nil, time.Date(2017, 11, 23, 0, 0, 0, 0, time.UTC)},
+		{US_Thanksgiving, time.Date(2017, 11, 24, 0, 0, 0, 0, time.UTC), nil, time.Date(2018, 11, 22, 0, 0, 0, 0, time.UTC)},
+		{US_Thanksgiving, time.Date(2017, 11, 24, 3, 0, 0, 0, time.UTC), tz, time.Date(2017, 11, 23, 0, 0, 0, 0, tz)},
+		{US_NewYear, time.Date(2017, 12, 31, 23, 0, 0, 0, time.UTC), jst, time.Date(2018, 1, 1, 0, 0, 0, 0, jst)},
+		{US_Thanksgiving, time.Date(2017, 11, 22, 23, 0, 0, 0, time.UTC), jst, time.Date(2017, 11, 23, 0, 0, 0, 0, jst)},
+		{US_Thanksgiving, time.Date(2017, 11, 23, 23, 0, 0, 0, time.UTC), jst, time.Date(2018, 11, 22, 0, 0, 0, 0, jst)},
+		{US_Christmas, time.Date(2017, 12, 26, 0, 0, 0, 0, time.UTC), nil, time.Date(2018, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{GB_GoodFriday, time.Date(2017, 4, 15, 0, 0, 0, 0, time.UTC), nil, time.Date(2018, 3, 30, 0, 0, 0, 0, time.UTC)},
+		{GB_NewYear, time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC), nil, time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{US_Juneteenth, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), nil, time.Date(2021, 6, 19, 0, 0, 0, 0, time.UTC)},
+		{Holiday{Month: time.May, Day: 1, ValidFrom: 2050}, time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC), nil, time.Date(2050, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{Holiday{Month: time.May, Day: 1, ValidTo: 2017}, time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC), nil, time.Time{}},
+	}
+
+	for _, test := range tests {
+		got := test.h.Next(test.t, test.loc)
+		if !got.Equal(test.want) {
+			t.Errorf("got: %s; want: %s (%s, %s)", got, test.want, test.h, test.t)
+		}
+	}
+}
+
+func TestHolidayRange(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(NewHolidayRange(time.December, 24, time.January, 1))
+	c.AddHoliday(NewHolidayRange(time.July, 28, time.August, 3))
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2016, 12, 23, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2016, 12, 24, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2016, 12, 31, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 1, 2, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2017, 7, 27, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2017, 7, 28, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 8, 3, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 8, 4, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestHolidayEveryDayAndNever(t *testing.T) {
+	always := NewCalendar()
+	always.AddHoliday(NewHolidayEveryDay())
+	never := NewCalendar()
+	never.SetWeekmask([7]bool{true, true, true, true, true, true, true})
+	never.AddHoliday(NewHolidayNever())
+
+	start := time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC)
+	end := time.Date(2016, 12, 31, 12, 0, 0, 0, time.UTC)
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		if !always.IsHoliday(date) || always.IsWorkday(date) {
+			t.Errorf("Expected %s to be a holiday", date)
+		}
+		if never.IsHoliday(date) || !never.IsWorkday(date) {
+			t.Errorf("Did not expect %s to be a holiday", date)
+		}
+	}
+
+	if got := always.CountWorkdays(start, end); got != 0 {
+		t.Errorf("got: %d; want: 0", got)
+	}
+	if got := never.CountWorkdays(start, end); got != 366 {
+		t.Errorf("got: %d; want: 366", got)
+	}
+	if got := always.Workdays(2016, time.February); got != 0 {
+		t.Errorf("got: %d; want: 0", got)
+	}
+	if got := always.NextWorkday(start); !got.IsZero() {
+		t.Errorf("got: %s; want: the zero Time", got)
+	}
+	if got := always.LastWorkdayOfYear(2016); !got.IsZero() {
+		t.Errorf("got: %s; want: the zero Time", got)
+	}
+	if got := always.LongWeekends(2016); len(got) > 1 {
+		t.Errorf("got: %v; want: at most one long weekend", got)
+	}
+	if got := never.LongWeekends(2016); len(got) != 0 {
+		t.Errorf("got: %v; want: no long weekends", got)
+	}
+}
+
+func TestHolidayByName(t *testing.T) {
+	c := NewBritishCalendar()
+
+	h, ok := c.HolidayByName("christmas day")
+	if !ok || h.Name != "Christmas Day" {
+		t.Fatalf("got: %v, %t; want: Christmas Day", h, ok)
+	}
+	want := time.Date(2017, 12, 25, 0, 0, 0, 0, time.UTC)
+	if got := h.Next(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), nil); !got.Equal(want) {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+
+	if h, ok := c.HolidayByName("Thanksgiving"); ok {
+		t.Errorf("got: %v; want: no holiday", h)
+	}
+
+	// the earliest month wins
+	c.AddHoliday(Holiday{Name: "Christmas Day", Month: time.January, Day: 7})
+	if h, _ := c.HolidayByName("Christmas Day"); h.Month != time.January {
+		t.Errorf("got: %s; want: January", h.Month)
+	}
+}
+
+func TestHolidayName(t *testing.T) {
+	tests := []struct {
+		c    *Calendar
+		t    time.Time
+		want string
+	}{
+		{NewUSCalendar(), time.Date(2017, 7, 4, 12, 0, 0, 0, time.UTC), "Independence Day"},
+		{NewUSCalendar(), time.Date(2017, 11, 23, 12, 0, 0, 0, time.UTC), "Thanksgiving Day"},
+		{NewECBCalendar(), time.Date(2017, 4, 14, 12, 0, 0, 0, time.UTC), "Good Friday"},
+		{NewGermanCalendar(), time.Date(2017, 10, 3, 12, 0, 0, 0, time.UTC), "Tag der Deutschen Einheit"},
+		{NewDutchCalendar(), time.Date(2017, 4, 27, 12, 0, 0, 0, time.UTC), "Koningsdag"},
+		{NewBritishCalendar(), time.Date(2017, 8, 28, 12, 0, 0, 0, time.UTC), "Summer Bank Holiday"},
+	}
+
+	for _, test := range tests {
+		got, ok := test.c.HolidayName(test.t)
+		if !ok || got != test.want {
+			t.Errorf("got: %q, %t; want: %q (%s)", got, ok, test.want, test.t)
+		}
+	}
+
+	if got, ok := NewUSCalendar().HolidayName(time.Date(2017, 7, 5, 12, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("got: %q; want: no holiday", got)
+	}
+
+	for _, code := range []string{"US", "ECB", "DE", "NL", "GB"} {
+		for _, h := range HolidaysForRegion(code) {
+			if h.Name == "" {
+				t.Errorf("Expected a name for %s (%s)", h, code)
+			}
+		}
+	}
+}
+
+func TestBritishRegionHolidays(t *testing.T) {
+	tests := []struct {
+		region string
+		t      time.Time
+		want   bool
+	}{
+		{"ENG", time.Date(2023, 4, 10, 12, 0, 0, 0, time.UTC), false}, // Easter Monday
+		{"WLS", time.Date(2023, 8, 28, 12, 0, 0, 0, time.UTC), false}, // Summer Bank Holiday
+		{"SCT", time.Date(2023, 4, 10, 12, 0, 0, 0, time.UTC), true},
+		{"SCT", time.Date(2023, 8, 7, 12, 0, 0, 0, time.UTC), false}, // Summer Bank Holiday
+		{"SCT", time.Date(2023, 8, 28, 12, 0, 0, 0, time.UTC), true},
+		{"SCT", time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC), false}, // New Year's Day
+		{"SCT", time.Date(2023, 1, 3, 12, 0, 0, 0, time.UTC), false}, // 2nd January
+		{"SCT", time.Date(2022, 1, 4, 12, 0, 0, 0, time.UTC), false}, // 2nd January
+		{"SCT", time.Date(2022, 1, 5, 12, 0, 0, 0, time.UTC), true},
+		{"SCT", time.Date(2023, 11, 30, 12, 0, 0, 0, time.UTC), false}, // St. Andrew's Day
+		{"SCT", time.Date(2024, 12, 2, 12, 0, 0, 0, time.UTC), false},  // St. Andrew's Day substitute
+		{"NIR", time.Date(2023, 3, 17, 12, 0, 0, 0, time.UTC), false},  // St. Patrick's Day
+		{"NIR", time.Date(2023, 7, 12, 12, 0, 0, 0, time.UTC), false},  // Battle of the Boyne
+		{"NIR", time.Date(2023, 4, 10, 12, 0, 0, 0, time.UTC), false},  // Easter Monday
+		{"ENG", time.Date(2023, 7, 12, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		c := NewCalendar()
+		c.Observed = ObservedMonday
+		if err := AddBritishRegionHolidays(c, test.region); err != nil {
+			t.Fatal(err)
+		}
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s %s)", got, test.want, test.region, test.t)
+		}
+	}
+
+	if err := AddBritishRegionHolidays(NewCalendar(), "GB"); err == nil {
+		t.Errorf("Expected an error for an unknown region")
+	}
+}
+
+func TestUSStateHolidays(t *testing.T) {
+	tests := []struct {
+		state string
+		t     time.Time
+		want  bool
+	}{
+		{"CA", time.Date(2023, 3, 31, 12, 0, 0, 0, time.UTC), false}, // Cesar Chavez Day
+		{"MA", time.Date(2023, 4, 17, 12, 0, 0, 0, time.UTC), false}, // Patriots' Day
+		{"ME", time.Date(2024, 4, 15, 12, 0, 0, 0, time.UTC), false}, // Patriots' Day
+		{"TX", time.Date(2023, 3, 2, 12, 0, 0, 0, time.UTC), false},  // Texas Independence Day
+		{"LA", time.Date(2024, 2, 13, 12, 0, 0, 0, time.UTC), false}, // Mardi Gras
+		{"HI", time.Date(2023, 8, 18, 12, 0, 0, 0, time.UTC), false}, // Statehood Day
+		{"AK", time.Date(2023, 3, 27, 12, 0, 0, 0, time.UTC), false}, // Seward's Day
+		{"CA", time.Date(2023, 4, 17, 12, 0, 0, 0, time.UTC), true},
+		{"TX", time.Date(2023, 7, 4, 12, 0, 0, 0, time.UTC), false},  // Independence Day
+		{"DC", time.Date(2025, 4, 16, 12, 0, 0, 0, time.UTC), false}, // DC Emancipation Day
+	}
+
+	for _, test := range tests {
+		c := NewCalendar()
+		if err := AddUSStateHolidays(c, test.state); err != nil {
+			t.Fatal(err)
+		}
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s %s)", got, test.want, test.state, test.t)
+		}
+	}
+
+	for _, state := range []string{"NY", "WY", "AL"} {
+		if err := AddUSStateHolidays(NewCalendar(), state); err != nil {
+			t.Errorf("unexpected error for %s: %v", state, err)
+		}
+	}
+	if len(usStateHolidays) != 51 {
+		t.Errorf("got %d states; want: 50 and DC", len(usStateHolidays))
+	}
+
+	if err := AddUSStateHolidays(NewCalendar(), "XX"); err == nil {
+		t.Errorf("Expected an error for an unknown state")
+	}
+}
+
+func TestUSMarketHolidays(t *testing.T) {
+	c := NewCalendar()
+	c.Observed = ObservedNearest
+	AddUSMarketHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2021, 12, 31, 12, 0, 0, 0, time.UTC), true},  // New Year's Day on Saturday
+		{time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC), false},   // New Year's Day on Sunday
+		{time.Date(2022, 4, 15, 12, 0, 0, 0, time.UTC), false},  // Good Friday
+		{time.Date(2022, 6, 20, 12, 0, 0, 0, time.UTC), false},  // Juneteenth on Sunday
+		{time.Date(2021, 6, 18, 12, 0, 0, 0, time.UTC), true},   // before the exchange kept Juneteenth
+		{time.Date(2022, 10, 10, 12, 0, 0, 0, time.UTC), true},  // Columbus Day
+		{time.Date(2022, 11, 11, 12, 0, 0, 0, time.UTC), true},  // Veterans Day
+		{time.Date(2022, 11, 24, 12, 0, 0, 0, time.UTC), false}, // Thanksgiving Day
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	c = NewCalendar()
+	AddUSFederalHolidays(c)
+	if !c.IsHoliday(time.Date(2022, 10, 10, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Columbus Day to be a federal holiday")
+	}
+}
+
+func TestHolidayInterval(t *testing.T) {
+	tests := []struct {
+		h    Holiday
+		t    time.Time
+		want bool
+	}{
+		{NLBevrijdingsDagLustrum, time.Date(2025, 5, 5, 12, 0, 0, 0, time.UTC), true},
+		{NLBevrijdingsDagLustrum, time.Date(2026, 5, 5, 12, 0, 0, 0, time.UTC), false},
+		{NLBevrijdingsDagLustrum, time.Date(1940, 5, 5, 12, 0, 0, 0, time.UTC), false},
+		{US_InaugurationDay, time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC), true},
+		{US_InaugurationDay, time.Date(2024, 1, 22, 12, 0, 0, 0, time.UTC), false},
+		{MX_TransmisionDePoder, time.Date(2018, 12, 1, 12, 0, 0, 0, time.UTC), true},
+		{MX_TransmisionDePoder, time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC), true},
+		{Holiday{Month: time.June, Day: 1, Interval: 3}, time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC), true},
+		{Holiday{Month: time.June, Day: 1, Interval: 3}, time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		c := NewCalendar()
+		c.AddHoliday(test.h)
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s %s)", got, test.want, test.h.Name, test.t)
+		}
+	}
+
+	want := time.Date(2030, 5, 5, 0, 0, 0, 0, time.UTC)
+	if got := NLBevrijdingsDagLustrum.Next(time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC), nil); !got.Equal(want) {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+}
+
+func TestRemoveHoliday(t *testing.T) {
+	c := NewBritishCalendar()
+	earlyMay := time.Date(2017, 5, 1, 12, 0, 0, 0, time.UTC)
+	n := countHolidays(c)
+
+	if !c.RemoveHoliday(GB_EarlyMay) {
+		t.Errorf("Expected GB_EarlyMay to be removed")
+	}
+	if c.IsHoliday(earlyMay) || countHolidays(c) != n-1 {
+		t.Errorf("Expected %s not to be a holiday", earlyMay)
+	}
+	if c.RemoveHoliday(GB_EarlyMay) {
+		t.Errorf("Did not expect GB_EarlyMay to be removed twice")
+	}
+
+	// a holiday on the same date under another name is a different holiday
+	if c.RemoveHoliday(ECB_ChristmasHoliday) {
+		t.Errorf("Did not expect ECB_ChristmasHoliday to be removed")
+	}
+
+	// holidays based on Easter or functions are removed as well
+	if !c.RemoveHoliday(GB_GoodFriday) || !c.RemoveHoliday(GB_NewYear) {
+		t.Errorf("Expected GB_GoodFriday and GB_NewYear to be removed")
+	}
+
+	c.ClearHolidays()
+	if got := countHolidays(c); got != 0 {
+		t.Errorf("got: %d holidays; want: 0", got)
+	}
+	AddBritishHolidays(c)
+	if got := countHolidays(c); got != n {
+		t.Errorf("got: %d holidays; want: %d", got, n)
+	}
+}
+
+func TestHolidayExact(t *testing.T) {
+	c := NewBritishCalendar()
+	c.AddHoliday(NewHolidayExact(2022, time.September, 19))
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2021, 9, 19, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2022, 9, 19, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, 9, 19, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
+
+func TestHolidayEasterOffset(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(NewHolidayEasterOffset(-47)) // Shrove Tuesday
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2016, 2, 9, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2017, 2, 28, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2018, 2, 13, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2018, 2, 14, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	if !NewHolidayEasterOffset(-2).Equal(Holiday{Easter: true, Offset: -2}) {
+		t.Errorf("Expected Good Friday to be 2 days before Easter")
+	}
+}
+
+func TestHolidayOrthodoxEasterOffset(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(NewHolidayOrthodoxEasterOffset(-2))
+	c.AddHoliday(NewHolidayEasterOffset(1))
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2016, 3, 25, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2016, 3, 28, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2016, 4, 29, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2016, 5, 2, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2021, 4, 30, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	// the Orthodox holiday does not depend on the calendar's method
+	c.EasterMethod = EasterJulian
+	if !c.IsHoliday(time.Date(2016, 4, 29, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the Orthodox Good Friday with the Julian method")
+	}
+	if NewHolidayOrthodoxEasterOffset(-2).Equal(NewHolidayEasterOffset(-2)) {
+		t.Errorf("Did not expect the Orthodox and the Western Good Friday to be equal")
+	}
+}
+
+func TestHolidayOnlyWeekdays(t *testing.T) {
+	c := NewCalendar()
+	c.AddHoliday(Holiday{
+		Name:         "Christmas Eve",
+		Month:        time.December,
+		Day:          24,
+		OnlyWeekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2015, 12, 24, 12, 0, 0, 0, time.UTC), true},  // Thursday
+		{time.Date(2016, 12, 24, 12, 0, 0, 0, time.UTC), false}, // Saturday
+		{time.Date(2017, 12, 24, 12, 0, 0, 0, time.UTC), false}, // Sunday
+		{time.Date(2018, 12, 24, 12, 0, 0, 0, time.UTC), true},  // Monday
+	}
+
+	for _, test := range tests {
+		got := c.IsHoliday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+
+	// not moved to the Monday after
+	if !c.IsWorkday(time.Date(2017, 12, 25, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Did not expect Christmas Eve to be observed on Monday")
+	}
+}
@@ -0,0 +1,55 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Turkey
+//
+// Ramazan Bayramı and Kurban Bayramı follow the Islamic calendar. The
+// afternoon before each of them, and before Cumhuriyet Bayramı, is a half
+// day. A holiday that falls on a weekend is not made up on a weekday.
+var (
+	TR_Yilbasi                = observedExact(named(US_NewYear, "Yılbaşı"))
+	TR_UlusalEgemenlik        = observedExact(NewNamedHoliday("Ulusal Egemenlik ve Çocuk Bayramı", time.April, 23))
+	TR_EmekVeDayanisma        = observedExact(named(ECB_LabourDay, "Emek ve Dayanışma Günü"))
+	TR_GenclikVeSpor          = observedExact(NewNamedHoliday("Atatürk'ü Anma, Gençlik ve Spor Bayramı", time.May, 19))
+	TR_DemokrasiVeMilliBirlik = observedExact(validFrom(NewNamedHoliday("Demokrasi ve Millî Birlik Günü", time.July, 15), 2017))
+	TR_ZaferBayrami           = observedExact(NewNamedHoliday("Zafer Bayramı", time.August, 30))
+	TR_CumhuriyetBayramiArife = observedExact(Holiday{Name: "Cumhuriyet Bayramı Arifesi", Month: time.October, Day: 28, HalfDay: true})
+	TR_CumhuriyetBayrami      = observedExact(NewNamedHoliday("Cumhuriyet Bayramı", time.October, 29))
+	TR_RamazanBayramiArife    = observedExact(Holiday{Name: "Ramazan Bayramı Arifesi", Hijri: true, Month: 10, Day: 1, Offset: -1, HalfDay: true})
+	TR_RamazanBayrami1        = observedExact(named(NewHolidayHijri(10, 1), "Ramazan Bayramı"))
+	TR_RamazanBayrami2        = observedExact(named(NewHolidayHijri(10, 2), "Ramazan Bayramı"))
+	TR_RamazanBayrami3        = observedExact(named(NewHolidayHijri(10, 3), "Ramazan Bayramı"))
+	TR_KurbanBayramiArife     = observedExact(Holiday{Name: "Kurban Bayramı Arifesi", Hijri: true, Month: 12, Day: 9, HalfDay: true})
+	TR_KurbanBayrami1         = observedExact(named(NewHolidayHijri(12, 10), "Kurban Bayramı"))
+	TR_KurbanBayrami2         = observedExact(named(NewHolidayHijri(12, 11), "Kurban Bayramı"))
+	TR_KurbanBayrami3         = observedExact(named(NewHolidayHijri(12, 12), "Kurban Bayramı"))
+	TR_KurbanBayrami4         = observedExact(named(NewHolidayHijri(12, 13), "Kurban Bayramı"))
+)
+
+var turkishHolidays = []Holiday{
+	TR_Yilbasi,
+	TR_UlusalEgemenlik,
+	TR_EmekVeDayanisma,
+	TR_GenclikVeSpor,
+	TR_DemokrasiVeMilliBirlik,
+	TR_ZaferBayrami,
+	TR_CumhuriyetBayramiArife,
+	TR_CumhuriyetBayrami,
+	TR_RamazanBayramiArife,
+	TR_RamazanBayrami1,
+	TR_RamazanBayrami2,
+	TR_RamazanBayrami3,
+	TR_KurbanBayramiArife,
+	TR_KurbanBayrami1,
+	TR_KurbanBayrami2,
+	TR_KurbanBayrami3,
+	TR_KurbanBayrami4,
+}
+
+// AddTurkishHolidays adds all Turkish holidays to Calendar
+func AddTurkishHolidays(c *Calendar) {
+	c.AddHolidays(turkishHolidays...)
+}
@@ -0,0 +1,113 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestTurkishHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddTurkishHolidays(c)
+
+	caltest.AssertHolidays(t, c, 2023, map[time.Time]string{
+		date(2023, 1, 1):   "Yılbaşı",
+		date(2023, 4, 20):  "Ramazan Bayramı Arifesi",
+		date(2023, 4, 21):  "Ramazan Bayramı",
+		date(2023, 4, 22):  "Ramazan Bayramı",
+		date(2023, 4, 23):  "Ramazan Bayramı",
+		date(2023, 5, 1):   "Emek ve Dayanışma Günü",
+		date(2023, 5, 19):  "Atatürk'ü Anma, Gençlik ve Spor Bayramı",
+		date(2023, 6, 27):  "Kurban Bayramı Arifesi",
+		date(2023, 6, 28):  "Kurban Bayramı",
+		date(2023, 6, 29):  "Kurban Bayramı",
+		date(2023, 6, 30):  "Kurban Bayramı",
+		date(2023, 7, 1):   "Kurban Bayramı",
+		date(2023, 7, 15):  "Demokrasi ve Millî Birlik Günü",
+		date(2023, 8, 30):  "Zafer Bayramı",
+		date(2023, 10, 28): "Cumhuriyet Bayramı Arifesi",
+		date(2023, 10, 29): "Cumhuriyet Bayramı",
+	})
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 4, 9), true}, // Ramazan Bayramı Arifesi, a half day
+		{date(2024, 4, 10), false},
+		{date(2024, 4, 12), false},
+		{date(2024, 4, 15), true},
+		{date(2024, 6, 16), false}, // Kurban Bayramı
+		{date(2024, 6, 19), false},
+		{date(2024, 6, 20), true},
+		{date(2022, 4, 22), true}, // Friday before Ulusal Egemenlik ve Çocuk Bayramı
+		{date(2020, 8, 31), true}, // Monday after Zafer Bayramı
+		{date(2024, 5, 20), true}, // Monday after Gençlik ve Spor Bayramı
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+	if got := c.WorkFraction(date(2024, 4, 9)); got != 0.5 {
+		t.Errorf("got: %g; want: 0.5", got)
+	}
+}
+
+func TestHijriHolidays(t *testing.T) {
+	eid := cal.NewHolidayHijri(10, 1)
+
+	// the Islamic year is shorter, so a holiday can occur twice in a year
+	first := eid.Next(date(2000, 1, 1), time.UTC)
+	second := eid.Next(first.AddDate(0, 0, 1), time.UTC)
+	if first.Year() != 2000 || second.Year() != 2000 {
+		t.Errorf("got: %s and %s; want: both in 2000", first, second)
+	}
+	if days := second.Sub(first).Hours() / 24; days != 354 && days != 355 {
+		t.Errorf("got: %g days between; want: 354 or 355", days)
+	}
+
+	// announced dates replace the calculated ones
+	want := time.Date(2023, 4, 21, 0, 0, 0, 0, time.UTC)
+	if got := eid.Next(date(2023, 1, 1), time.UTC); !got.Equal(want) {
+		t.Errorf("got: %s; want: %s", got, want)
+	}
+}
+
+func TestSetHijriMonthStart(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddTurkishHolidays(c)
+	other := cal.NewCalendar()
+	cal.AddTurkishHolidays(other)
+
+	// Shawwal 1447 announced a day later than known
+	c.SetHijriMonthStart(1447, 10, date(2026, 3, 21))
+
+	tests := []struct {
+		c    *cal.Calendar
+		t    time.Time
+		want string
+	}{
+		{c, date(2026, 3, 20), "Ramazan Bayramı Arifesi"},
+		{c, date(2026, 3, 21), "Ramazan Bayramı"},
+		{c, date(2026, 3, 23), "Ramazan Bayramı"},
+		{other, date(2026, 3, 20), "Ramazan Bayramı"},
+		{other, date(2026, 3, 23), ""},
+	}
+
+	for _, test := range tests {
+		got, _ := test.c.HolidayName(test.t)
+		if got != test.want {
+			t.Errorf("got: %q; want: %q (%s)", got, test.want, test.t)
+		}
+	}
+
+	merged := cal.MergeCalendars(cal.NewCalendar(), c)
+	if got, _ := merged.HolidayName(date(2026, 3, 23)); got != "Ramazan Bayramı" {
+		t.Errorf("got: %q; want the month start of the merged calendar", got)
+	}
+}
@@ -0,0 +1,58 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in Ukraine
+//
+// A holiday falling on a weekend is moved to the next Monday. The Day of
+// Defenders moved to October 1st in 2023, and since 2024 Christmas is kept on
+// December 25th only and Statehood Day on July 15th. The suspension of days off
+// under martial law since 2022 is not reflected.
+var (
+	UA_NovyiRik         = uaHoliday(named(US_NewYear, "Новий рік"))
+	UA_RizdvoOrthodox   = uaHoliday(validTo(NewNamedHoliday("Різдво Христове", time.January, 7), 2023))
+	UA_ZhinochyiDen     = uaHoliday(NewNamedHoliday("Міжнародний жіночий день", time.March, 8))
+	UA_Velykden         = uaHoliday(named(NewHolidayOrthodoxEasterOffset(0), "Великдень"))
+	UA_DenPratsi        = uaHoliday(named(ECB_LabourDay, "День праці"))
+	UA_DenPratsi2       = uaHoliday(validTo(NewNamedHoliday("День праці", time.May, 2), 2017))
+	UA_DenPamiati       = uaHoliday(validFrom(NewNamedHoliday("День пам'яті та перемоги над нацизмом у Другій світовій війні 1939–1945 років", time.May, 8), 2024))
+	UA_DenPeremohy      = uaHoliday(validTo(NewNamedHoliday("День перемоги над нацизмом у Другій світовій війні", time.May, 9), 2023))
+	UA_Triitsia         = uaHoliday(named(NewHolidayOrthodoxEasterOffset(49), "Трійця"))
+	UA_DenKonstytutsii  = uaHoliday(NewNamedHoliday("День Конституції України", time.June, 28))
+	UA_DenDerzhavnosti  = uaHoliday(validFrom(NewNamedHoliday("День Української Державності", time.July, 15), 2024))
+	UA_DenNezalezhnosti = uaHoliday(NewNamedHoliday("День Незалежності України", time.August, 24))
+	UA_DenZakhysnykiv   = uaHoliday(validFrom(NewNamedHoliday("День захисників і захисниць України", time.October, 1), 2023))
+	UA_Rizdvo           = uaHoliday(validFrom(named(ECB_ChristmasDay, "Різдво Христове"), 2017))
+)
+
+var ukrainianHolidays = []Holiday{
+	UA_NovyiRik,
+	UA_RizdvoOrthodox,
+	UA_ZhinochyiDen,
+	UA_Velykden,
+	UA_DenPratsi,
+	UA_DenPratsi2,
+	UA_DenPamiati,
+	UA_DenPeremohy,
+	UA_Triitsia,
+	UA_DenKonstytutsii,
+	uaHoliday(Holiday{Name: "День Української Державності", Month: time.July, Day: 28, ValidFrom: 2022, ValidTo: 2023}),
+	UA_DenDerzhavnosti,
+	UA_DenNezalezhnosti,
+	uaHoliday(Holiday{Name: "День захисників і захисниць України", Month: time.October, Day: 14, ValidFrom: 2015, ValidTo: 2022}),
+	UA_DenZakhysnykiv,
+	UA_Rizdvo,
+}
+
+// uaHoliday returns a copy of the holiday that is moved from a weekend to the
+// next Monday.
+func uaHoliday(h Holiday) Holiday {
+	return NewHolidayObserved(h, ObservedMonday)
+}
+
+// AddUkrainianHolidays adds all Ukrainian holidays to Calendar
+func AddUkrainianHolidays(c *Calendar) {
+	c.AddHolidays(ukrainianHolidays...)
+}
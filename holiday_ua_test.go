@@ -0,0 +1,67 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestUkrainianHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("UA")...)
+
+	caltest.AssertHolidays(t, c, 2024, map[time.Time]string{
+		date(2024, 1, 1):   "Новий рік",
+		date(2024, 3, 8):   "Міжнародний жіночий день",
+		date(2024, 5, 1):   "День праці",
+		date(2024, 5, 5):   "Великдень",
+		date(2024, 5, 8):   "День пам'яті та перемоги над нацизмом у Другій світовій війні 1939–1945 років",
+		date(2024, 6, 23):  "Трійця",
+		date(2024, 6, 28):  "День Конституції України",
+		date(2024, 7, 15):  "День Української Державності",
+		date(2024, 8, 24):  "День Незалежності України",
+		date(2024, 10, 1):  "День захисників і захисниць України",
+		date(2024, 12, 25): "Різдво Христове",
+	})
+
+	caltest.AssertHolidays(t, c, 2022, map[time.Time]string{
+		date(2022, 1, 1):   "Новий рік",
+		date(2022, 1, 7):   "Різдво Христове",
+		date(2022, 3, 8):   "Міжнародний жіночий день",
+		date(2022, 4, 24):  "Великдень",
+		date(2022, 5, 1):   "День праці",
+		date(2022, 5, 9):   "День перемоги над нацизмом у Другій світовій війні",
+		date(2022, 6, 12):  "Трійця",
+		date(2022, 6, 28):  "День Конституції України",
+		date(2022, 7, 28):  "День Української Державності",
+		date(2022, 8, 24):  "День Незалежності України",
+		date(2022, 10, 14): "День захисників і захисниць України",
+		date(2022, 12, 25): "Різдво Христове",
+	})
+}
+
+func TestUkrainianObserved(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddUkrainianHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2024, 5, 6), false},  // Великдень
+		{date(2024, 6, 24), false}, // Трійця
+		{date(2016, 5, 2), false},  // День праці
+		{date(2016, 5, 3), false},  // 1 May on Sunday
+		{date(2018, 5, 2), true},
+		{date(2024, 1, 8), true}, // Orthodox Christmas no longer kept
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// Holidays in South Africa
+//
+// Under the Public Holidays Act a holiday that falls on a Sunday is observed
+// on the Monday after, even when that Monday is a holiday of its own; a
+// holiday on a Saturday is not moved.
+var (
+	ZA_NewYear           = zaHoliday(US_NewYear)
+	ZA_HumanRightsDay    = zaHoliday(NewNamedHoliday("Human Rights Day", time.March, 21))
+	ZA_GoodFriday        = zaHoliday(ECB_GoodFriday)
+	ZA_FamilyDay         = zaHoliday(named(ECB_EasterMonday, "Family Day"))
+	ZA_FreedomDay        = zaHoliday(NewNamedHoliday("Freedom Day", time.April, 27))
+	ZA_WorkersDay        = zaHoliday(named(ECB_LabourDay, "Workers' Day"))
+	ZA_YouthDay          = zaHoliday(NewNamedHoliday("Youth Day", time.June, 16))
+	ZA_WomensDay         = zaHoliday(NewNamedHoliday("National Women's Day", time.August, 9))
+	ZA_HeritageDay       = zaHoliday(NewNamedHoliday("Heritage Day", time.September, 24))
+	ZA_ReconciliationDay = zaHoliday(NewNamedHoliday("Day of Reconciliation", time.December, 16))
+	ZA_ChristmasDay      = zaHoliday(ECB_ChristmasDay)
+	ZA_DayOfGoodwill     = zaHoliday(named(ECB_ChristmasHoliday, "Day of Goodwill"))
+)
+
+var southAfricanHolidays = []Holiday{
+	ZA_NewYear,
+	ZA_HumanRightsDay,
+	ZA_GoodFriday,
+	ZA_FamilyDay,
+	ZA_FreedomDay,
+	ZA_WorkersDay,
+	ZA_YouthDay,
+	ZA_WomensDay,
+	ZA_HeritageDay,
+	ZA_ReconciliationDay,
+	ZA_ChristmasDay,
+	ZA_DayOfGoodwill,
+}
+
+// zaHoliday returns a copy of the holiday observed according to the Public
+// Holidays Act, which took effect in 1995.
+func zaHoliday(h Holiday) Holiday {
+	h.ObservedFunc = observedSouthAfrican
+	return validFrom(h, 1995)
+}
+
+// observedSouthAfrican moves a holiday on a Sunday to the Monday after.
+func observedSouthAfrican(date time.Time, c *Calendar) time.Time {
+	if date.Weekday() == time.Sunday {
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// AddSouthAfricanHolidays adds all South African holidays to Calendar
+func AddSouthAfricanHolidays(c *Calendar) {
+	c.AddHolidays(southAfricanHolidays...)
+}
@@ -0,0 +1,52 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickar/cal"
+	"github.com/rickar/cal/caltest"
+)
+
+func TestSouthAfricanHolidays(t *testing.T) {
+	c := cal.NewCalendar()
+	c.AddHolidays(cal.HolidaysForRegion("ZA")...)
+
+	caltest.AssertHolidays(t, c, 2025, map[time.Time]string{
+		date(2025, 1, 1):   "New Year's Day",
+		date(2025, 3, 21):  "Human Rights Day",
+		date(2025, 4, 18):  "Good Friday",
+		date(2025, 4, 21):  "Family Day",
+		date(2025, 4, 27):  "Freedom Day",
+		date(2025, 5, 1):   "Workers' Day",
+		date(2025, 6, 16):  "Youth Day",
+		date(2025, 8, 9):   "National Women's Day",
+		date(2025, 9, 24):  "Heritage Day",
+		date(2025, 12, 16): "Day of Reconciliation",
+		date(2025, 12, 25): "Christmas Day",
+		date(2025, 12, 26): "Day of Goodwill",
+	})
+}
+
+func TestSouthAfricanObserved(t *testing.T) {
+	c := cal.NewCalendar()
+	cal.AddSouthAfricanHolidays(c)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{date(2025, 4, 28), false}, // Freedom Day on Sunday
+		{date(2025, 8, 11), true},  // Women's Day on Saturday is not moved
+		{date(2022, 12, 26), false},
+		{date(2022, 12, 27), true},  // Christmas on Sunday is not moved past Day of Goodwill
+		{date(2021, 12, 27), false}, // Day of Goodwill on Sunday
+	}
+
+	for _, test := range tests {
+		got := c.IsWorkday(test.t)
+		if got != test.want {
+			t.Errorf("got: %t; want: %t (%s)", got, test.want, test.t)
+		}
+	}
+}
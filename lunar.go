@@ -0,0 +1,81 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import "time"
+
+// monthDay is a day of a month in the Gregorian calendar.
+type monthDay struct {
+	month time.Month
+	day   int
+}
+
+// lunarYear holds the Gregorian dates of the festivals of a year of the
+// Chinese lunisolar calendar.
+type lunarYear struct {
+	newYear     monthDay // 1st day of the 1st month
+	buddha      monthDay // 8th day of the 4th month
+	dragonBoat  monthDay // 5th day of the 5th month
+	midAutumn   monthDay // 15th day of the 8th month
+	doubleNinth monthDay // 9th day of the 9th month
+}
+
+// lunarYears holds the festivals of the Chinese lunisolar calendar for the
+// Gregorian years 2015 through 2030. The lunisolar months begin with
+// astronomical new moons rather than by a simple rule, so the festivals are
+// not reported for other years.
+var lunarYears = map[int]lunarYear{
+	2015: {monthDay{time.February, 19}, monthDay{time.May, 25}, monthDay{time.June, 20}, monthDay{time.September, 27}, monthDay{time.October, 21}},
+	2016: {monthDay{time.February, 8}, monthDay{time.May, 14}, monthDay{time.June, 9}, monthDay{time.September, 15}, monthDay{time.October, 9}},
+	2017: {monthDay{time.January, 28}, monthDay{time.May, 3}, monthDay{time.May, 30}, monthDay{time.October, 4}, monthDay{time.October, 28}},
+	2018: {monthDay{time.February, 16}, monthDay{time.May, 22}, monthDay{time.June, 18}, monthDay{time.September, 24}, monthDay{time.October, 17}},
+	2019: {monthDay{time.February, 5}, monthDay{time.May, 12}, monthDay{time.June, 7}, monthDay{time.September, 13}, monthDay{time.October, 7}},
+	2020: {monthDay{time.January, 25}, monthDay{time.April, 30}, monthDay{time.June, 25}, monthDay{time.October, 1}, monthDay{time.October, 25}},
+	2021: {monthDay{time.February, 12}, monthDay{time.May, 19}, monthDay{time.June, 14}, monthDay{time.September, 21}, monthDay{time.October, 14}},
+	2022: {monthDay{time.February, 1}, monthDay{time.May, 8}, monthDay{time.June, 3}, monthDay{time.September, 10}, monthDay{time.October, 4}},
+	2023: {monthDay{time.January, 22}, monthDay{time.May, 26}, monthDay{time.June, 22}, monthDay{time.September, 29}, monthDay{time.October, 23}},
+	2024: {monthDay{time.February, 10}, monthDay{time.May, 15}, monthDay{time.June, 10}, monthDay{time.September, 17}, monthDay{time.October, 11}},
+	2025: {monthDay{time.January, 29}, monthDay{time.May, 5}, monthDay{time.May, 31}, monthDay{time.October, 6}, monthDay{time.October, 29}},
+	2026: {monthDay{time.February, 17}, monthDay{time.May, 24}, monthDay{time.June, 19}, monthDay{time.September, 25}, monthDay{time.October, 18}},
+	2027: {monthDay{time.February, 6}, monthDay{time.May, 13}, monthDay{time.June, 9}, monthDay{time.September, 15}, monthDay{time.October, 8}},
+	2028: {monthDay{time.January, 26}, monthDay{time.May, 2}, monthDay{time.May, 28}, monthDay{time.October, 3}, monthDay{time.October, 26}},
+	2029: {monthDay{time.February, 13}, monthDay{time.May, 20}, monthDay{time.June, 16}, monthDay{time.September, 22}, monthDay{time.October, 16}},
+	2030: {monthDay{time.February, 3}, monthDay{time.May, 9}, monthDay{time.June, 5}, monthDay{time.September, 12}, monthDay{time.October, 5}},
+}
+
+// Chinese New Year is the 1st day of the 1st lunar month.
+func calculateLunarNewYear(year int, loc *time.Location) (time.Month, int, bool) {
+	y, ok := lunarYears[year]
+	return y.newYear.month, y.newYear.day, ok
+}
+
+// Buddha's Birthday is the 8th day of the 4th lunar month.
+func calculateBuddhasBirthday(year int, loc *time.Location) (time.Month, int, bool) {
+	y, ok := lunarYears[year]
+	return y.buddha.month, y.buddha.day, ok
+}
+
+// The Dragon Boat Festival is the 5th day of the 5th lunar month.
+func calculateDragonBoat(year int, loc *time.Location) (time.Month, int, bool) {
+	y, ok := lunarYears[year]
+	return y.dragonBoat.month, y.dragonBoat.day, ok
+}
+
+// The Mid-Autumn Festival is the 15th day of the 8th lunar month.
+func calculateMidAutumn(year int, loc *time.Location) (time.Month, int, bool) {
+	y, ok := lunarYears[year]
+	return y.midAutumn.month, y.midAutumn.day, ok
+}
+
+// The Double Ninth Festival is the 9th day of the 9th lunar month.
+func calculateDoubleNinth(year int, loc *time.Location) (time.Month, int, bool) {
+	y, ok := lunarYears[year]
+	return y.doubleNinth.month, y.doubleNinth.day, ok
+}
+
+// Qingming is the day of the solar term of the same name, an approximation
+// that holds for the years 2000 through 2099.
+func calculateQingming(year int, loc *time.Location) (time.Month, int) {
+	y := year % 100
+	return time.April, int(float64(y)*0.2422+4.81) - y/4
+}
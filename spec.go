@@ -0,0 +1,394 @@
+// (c) 2014 Rick Arnold. Licensed under the BSD license (see LICENSE).
+
+package cal
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// CalendarSpec is a plain representation of a Calendar without function
+// values, suitable for encoding with JSON, YAML, protocol buffers and the
+// like. See Calendar.ToSpec and FromSpec.
+type CalendarSpec struct {
+	Observed         ObservedRule          `json:"observed"`
+	Location         string                `json:"location,omitempty"`
+	EasterMethod     EasterMethod          `json:"easterMethod"`
+	Workdays         [7]bool               `json:"workdays"`
+	Weekmasks        []WeekmaskSpec        `json:"weekmasks,omitempty"`
+	WorkdayOverrides []string              `json:"workdayOverrides,omitempty"` // as 2006-01-02
+	Holidays         []HolidaySpec         `json:"holidays"`
+	HijriMonthStarts []HijriMonthStartSpec `json:"hijriMonthStarts,omitempty"`
+}
+
+// WeekmaskSpec is a work week set with Calendar.SetWeekmaskFrom.
+type WeekmaskSpec struct {
+	From     string  `json:"from"` // as 2006-01-02
+	Workdays [7]bool `json:"workdays"`
+}
+
+// HijriMonthStartSpec is a month start set with Calendar.SetHijriMonthStart.
+type HijriMonthStartSpec struct {
+	Year  int    `json:"year"`
+	Month int    `json:"month"`
+	Start string `json:"start"` // as 2006-01-02
+}
+
+// HolidaySpec is a plain representation of a Holiday. Functions are referred
+// to by the key they were registered with; see RegisterHolidayFunc,
+// RegisterHolidayFuncOK and RegisterObservedFunc.
+type HolidaySpec struct {
+	Name         string         `json:"name,omitempty"`
+	Month        time.Month     `json:"month,omitempty"`
+	Weekday      time.Weekday   `json:"weekday,omitempty"`
+	Day          int            `json:"day,omitempty"`
+	EndMonth     time.Month     `json:"endMonth,omitempty"`
+	EndDay       int            `json:"endDay,omitempty"`
+	Offset       int            `json:"offset,omitempty"`
+	Clamp        bool           `json:"clamp,omitempty"`
+	Easter       bool           `json:"easter,omitempty"`
+	Orthodox     bool           `json:"orthodox,omitempty"`
+	Hijri        bool           `json:"hijri,omitempty"`
+	Hebrew       bool           `json:"hebrew,omitempty"`
+	Base         *HolidaySpec   `json:"base,omitempty"`
+	Func         string         `json:"func,omitempty"`
+	FuncOK       string         `json:"funcOK,omitempty"`
+	ValidFrom    int            `json:"validFrom,omitempty"`
+	ValidTo      int            `json:"validTo,omitempty"`
+	Interval     int            `json:"interval,omitempty"`
+	OnlyWeekdays []time.Weekday `json:"onlyWeekdays,omitempty"`
+	HalfDay      bool           `json:"halfDay,omitempty"`
+	Observed     *ObservedRule  `json:"observed,omitempty"`
+	ObservedFunc string         `json:"observedFunc,omitempty"`
+}
+
+// holidayFuncs maps the keys of HolidayFns to the functions.
+var holidayFuncs = map[string]HolidayFn{
+	"election":                   calculateElection,
+	"bussUndBettag":              calculateBussUndBettag,
+	"koningsDag":                 calculateKoningsDag,
+	"newYearsHoliday":            calculateNewYearsHoliday,
+	"secondJanuary":              calculateSecondJanuary,
+	"victoriaDay":                calculateVictoriaDay,
+	"roshHashanah":               calculateRoshHashanah,
+	"passover":                   calculatePassover,
+	"yomHaatzmaut":               calculateYomHaatzmaut,
+	"aucklandAnniversary":        calculateAucklandAnniversary,
+	"wellingtonAnniversary":      calculateWellingtonAnniversary,
+	"nelsonAnniversary":          calculateNelsonAnniversary,
+	"otagoAnniversary":           calculateOtagoAnniversary,
+	"canterburyAnniversary":      calculateCanterburyAnniversary,
+	"chathamAnniversary":         calculateChathamAnniversary,
+	"westlandAnniversary":        calculateWestlandAnniversary,
+	"stBrigidsDay":               calculateStBrigidsDay,
+	"jeuneGenevois":              calculateJeuneGenevois,
+	"bettagsmontag":              calculateBettagsmontag,
+	"vernalEquinox":              calculateVernalEquinox,
+	"autumnalEquinox":            calculateAutumnalEquinox,
+	"marineDay":                  calculateMarineDay,
+	"mountainDay":                calculateMountainDay,
+	"sportsDay":                  calculateSportsDay,
+	"guemes":                     calculateGuemes,
+	"sanMartin":                  calculateSanMartin,
+	"diversidadCultural":         calculateDiversidadCultural,
+	"soberaniaNacional":          calculateSoberaniaNacional,
+	"qingming":                   calculateQingming,
+	"firstWeekdayAfterChristmas": calculateFirstWeekdayAfterChristmas,
+	"inaugurationDay":            calculateInaugurationDay,
+	"transmisionDePoder":         calculateTransmisionDePoder,
+}
+
+// holidayFuncsOK maps the keys of HolidayFnOKs to the functions.
+var holidayFuncsOK = map[string]HolidayFnOK{
+	"lunarNewYear":          calculateLunarNewYear,
+	"buddhasBirthday":       calculateBuddhasBirthday,
+	"koreanBuddhasBirthday": calculateKoreanBuddhasBirthday,
+	"dragonBoat":            calculateDragonBoat,
+	"midAutumn":             calculateMidAutumn,
+	"doubleNinth":           calculateDoubleNinth,
+	"holi":                  calculateHoli,
+	"idUlFitr":              calculateIdUlFitr,
+	"bakrid":                calculateBakrid,
+	"dussehra":              calculateDussehra,
+	"diwali":                calculateDiwali,
+	"citizensHoliday":       calculateCitizensHoliday,
+	"matariki":              calculateMatariki,
+	"hariRayaPuasa":         calculateHariRayaPuasa,
+	"vesak":                 calculateVesak,
+	"hariRayaHaji":          calculateHariRayaHaji,
+	"deepavali":             calculateDeepavali,
+}
+
+// observedFuncs maps the keys of ObservedFns to the functions.
+var observedFuncs = map[string]ObservedFn{
+	"southAfrican":    observedSouthAfrican,
+	"chingMing":       observedChingMing,
+	"russian":         observedRussian,
+	"koreanLunar":     krObservedLunar,
+	"koreanChildren":  krObservedChildren,
+	"koreanNational":  krObservedNational,
+	"koreanBuddha":    krObservedBuddha,
+	"koreanChristmas": krObservedChristmas,
+}
+
+// RegisterHolidayFunc registers a HolidayFn under a key so that holidays
+// using it can be converted to and from a HolidaySpec. It is not safe to call
+// concurrently with other functions of this package and is meant to be called
+// during initialization.
+func RegisterHolidayFunc(key string, fn HolidayFn) {
+	holidayFuncs[key] = fn
+}
+
+// RegisterHolidayFuncOK registers a HolidayFnOK under a key, as
+// RegisterHolidayFunc does for a HolidayFn.
+func RegisterHolidayFuncOK(key string, fn HolidayFnOK) {
+	holidayFuncsOK[key] = fn
+}
+
+// RegisterObservedFunc registers an ObservedFn under a key, as
+// RegisterHolidayFunc does for a HolidayFn.
+func RegisterObservedFunc(key string, fn ObservedFn) {
+	observedFuncs[key] = fn
+}
+
+// funcKey reports the key a HolidayFn was registered with.
+func funcKey(fn HolidayFn) (string, bool) {
+	p := funcPointer(fn)
+	for key, f := range holidayFuncs {
+		if funcPointer(f) == p {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// funcOKKey reports the key a HolidayFnOK was registered with.
+func funcOKKey(fn HolidayFnOK) (string, bool) {
+	p := funcPointer(fn)
+	for key, f := range holidayFuncsOK {
+		if funcPointer(f) == p {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// observedKey reports the key an ObservedFn was registered with.
+func observedKey(fn ObservedFn) (string, bool) {
+	p := funcPointer(fn)
+	for key, f := range observedFuncs {
+		if funcPointer(f) == p {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// ToSpec converts the calendar to a CalendarSpec. It returns an error if the
+// calendar uses a function that cannot be represented: a WorkdayFunc or an
+// unregistered HolidayFn, HolidayFnOK or ObservedFn.
+func (c *Calendar) ToSpec() (CalendarSpec, error) {
+	spec := CalendarSpec{
+		Observed:     c.Observed,
+		EasterMethod: c.EasterMethod,
+		Workdays:     c.workday,
+	}
+	if c.Location != nil {
+		spec.Location = c.Location.String()
+	}
+	if c.WorkdayFunc != nil {
+		return spec, errors.New("cal: a WorkdayFunc cannot be represented in a spec")
+	}
+	for _, w := range c.weekmasks {
+		from := time.Date(w.from.year, w.from.month, w.from.day, 0, 0, 0, 0, time.UTC)
+		spec.Weekmasks = append(spec.Weekmasks, WeekmaskSpec{
+			From:     from.Format("2006-01-02"),
+			Workdays: w.mask,
+		})
+	}
+	for idx := range c.holidays {
+		for i := range c.holidays[idx] {
+			hs, err := c.holidays[idx][i].toSpec()
+			if err != nil {
+				return spec, err
+			}
+			spec.Holidays = append(spec.Holidays, hs)
+		}
+	}
+	for key := range c.overrides {
+		date := time.Date(key.year, key.month, key.day, 0, 0, 0, 0, time.UTC)
+		spec.WorkdayOverrides = append(spec.WorkdayOverrides, date.Format("2006-01-02"))
+	}
+	sort.Strings(spec.WorkdayOverrides)
+	for m, d := range c.hijriStarts {
+		spec.HijriMonthStarts = append(spec.HijriMonthStarts, HijriMonthStartSpec{
+			Year:  m.year,
+			Month: m.month,
+			Start: fixedDate(d, time.UTC).Format("2006-01-02"),
+		})
+	}
+	sort.Slice(spec.HijriMonthStarts, func(i, j int) bool {
+		a, b := spec.HijriMonthStarts[i], spec.HijriMonthStarts[j]
+		return a.Year < b.Year || (a.Year == b.Year && a.Month < b.Month)
+	})
+	return spec, nil
+}
+
+// toSpec converts the holiday to a HolidaySpec.
+func (h *Holiday) toSpec() (HolidaySpec, error) {
+	hs := HolidaySpec{
+		Name:         h.Name,
+		Month:        h.Month,
+		Weekday:      h.Weekday,
+		Day:          h.Day,
+		EndMonth:     h.EndMonth,
+		EndDay:       h.EndDay,
+		Offset:       h.Offset,
+		Clamp:        h.Clamp,
+		Easter:       h.Easter,
+		Orthodox:     h.Orthodox,
+		Hijri:        h.Hijri,
+		Hebrew:       h.Hebrew,
+		ValidFrom:    h.ValidFrom,
+		ValidTo:      h.ValidTo,
+		Interval:     h.Interval,
+		OnlyWeekdays: h.OnlyWeekdays,
+		HalfDay:      h.HalfDay,
+		Observed:     h.Observed,
+	}
+	if h.FuncOK != nil {
+		key, ok := funcOKKey(h.FuncOK)
+		if !ok {
+			return hs, fmt.Errorf("cal: holiday %s uses an unregistered HolidayFnOK", h)
+		}
+		hs.FuncOK, hs.Month, hs.Day = key, 0, 0
+	}
+	if h.ObservedFunc != nil {
+		key, ok := observedKey(h.ObservedFunc)
+		if !ok {
+			return hs, fmt.Errorf("cal: holiday %s uses an unregistered ObservedFn", h)
+		}
+		hs.ObservedFunc = key
+	}
+	if h.Func != nil {
+		key, ok := funcKey(h.Func)
+		if !ok {
+			return hs, fmt.Errorf("cal: holiday %s uses an unregistered HolidayFn", h)
+		}
+		// Month and Day are calculated values
+		hs.Func, hs.Month, hs.Day = key, 0, 0
+	}
+	if h.Base != nil {
+		base, err := h.Base.toSpec()
+		if err != nil {
+			return hs, err
+		}
+		hs.Base = &base
+	}
+	return hs, nil
+}
+
+// FromSpec creates a new Calendar from a CalendarSpec. It returns an error if
+// the spec has a work week with no work days or refers to an unknown time
+// zone or function.
+func FromSpec(spec CalendarSpec) (*Calendar, error) {
+	c := NewCalendar()
+	c.Observed = spec.Observed
+	c.EasterMethod = spec.EasterMethod
+	if err := c.SetWeekmask(spec.Workdays); err != nil {
+		return nil, err
+	}
+	if spec.Location != "" {
+		loc, err := time.LoadLocation(spec.Location)
+		if err != nil {
+			return nil, err
+		}
+		c.Location = loc
+	}
+	for _, w := range spec.Weekmasks {
+		from, err := time.ParseInLocation("2006-01-02", w.From, c.loc())
+		if err != nil {
+			return nil, err
+		}
+		if err := c.SetWeekmaskFrom(from, w.Workdays); err != nil {
+			return nil, err
+		}
+	}
+	for _, hs := range spec.Holidays {
+		h, err := hs.holiday()
+		if err != nil {
+			return nil, err
+		}
+		c.AddHoliday(h)
+	}
+	for _, o := range spec.WorkdayOverrides {
+		date, err := time.ParseInLocation("2006-01-02", o, c.loc())
+		if err != nil {
+			return nil, err
+		}
+		c.AddWorkdayOverride(date)
+	}
+	for _, m := range spec.HijriMonthStarts {
+		date, err := time.Parse("2006-01-02", m.Start)
+		if err != nil {
+			return nil, err
+		}
+		c.SetHijriMonthStart(m.Year, m.Month, date)
+	}
+	return c, nil
+}
+
+// holiday converts the spec to a Holiday.
+func (hs HolidaySpec) holiday() (Holiday, error) {
+	h := Holiday{
+		Name:         hs.Name,
+		Month:        hs.Month,
+		Weekday:      hs.Weekday,
+		Day:          hs.Day,
+		EndMonth:     hs.EndMonth,
+		EndDay:       hs.EndDay,
+		Offset:       hs.Offset,
+		Clamp:        hs.Clamp,
+		Easter:       hs.Easter,
+		Orthodox:     hs.Orthodox,
+		Hijri:        hs.Hijri,
+		Hebrew:       hs.Hebrew,
+		ValidFrom:    hs.ValidFrom,
+		ValidTo:      hs.ValidTo,
+		Interval:     hs.Interval,
+		OnlyWeekdays: hs.OnlyWeekdays,
+		HalfDay:      hs.HalfDay,
+		Observed:     hs.Observed,
+	}
+	if hs.Func != "" {
+		fn, ok := holidayFuncs[hs.Func]
+		if !ok {
+			return h, fmt.Errorf("cal: unknown HolidayFn %q", hs.Func)
+		}
+		h.Func = fn
+	}
+	if hs.FuncOK != "" {
+		fn, ok := holidayFuncsOK[hs.FuncOK]
+		if !ok {
+			return h, fmt.Errorf("cal: unknown HolidayFnOK %q", hs.FuncOK)
+		}
+		h.FuncOK = fn
+	}
+	if hs.ObservedFunc != "" {
+		fn, ok := observedFuncs[hs.ObservedFunc]
+		if !ok {
+			return h, fmt.Errorf("cal: unknown ObservedFn %q", hs.ObservedFunc)
+		}
+		h.ObservedFunc = fn
+	}
+	if hs.Base != nil {
+		base, err := hs.Base.holiday()
+		if err != nil {
+			return h, err
+		}
+		h.Base = &base
+	}
+	return h, nil
+}
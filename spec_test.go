@@ -0,0 +1,170 @@
+package cal
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSpecRoundTrip(t *testing.T) {
+	c := NewBritishCalendar()
+	c.AddHoliday(NewHolidayRelative(GB_SpringHoliday, 1))
+	c.AddWorkdayOverride(time.Date(2017, 6, 3, 12, 0, 0, 0, time.UTC))
+	c.AddHoliday(named(NewHolidayHijri(10, 1), "Eid al-Fitr"))
+	c.SetHijriMonthStart(1438, 10, time.Date(2017, 6, 27, 0, 0, 0, 0, time.UTC))
+
+	spec, err := c.ToSpec()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded CalendarSpec
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(spec, decoded) {
+		t.Errorf("got: %+v; want: %+v", decoded, spec)
+	}
+
+	d, err := FromSpec(decoded)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Observed != c.Observed {
+		t.Errorf("got observed rule: %d; want: %d", d.Observed, c.Observed)
+	}
+	again, err := d.ToSpec()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(spec, again) {
+		t.Errorf("got: %+v; want: %+v", again, spec)
+	}
+
+	if !d.IsHoliday(time.Date(2017, 6, 27, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the Hijri month start to be kept")
+	}
+	for date := time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC); date.Year() < 2019; date = date.AddDate(0, 0, 1) {
+		if c.IsWorkday(date) != d.IsWorkday(date) {
+			t.Errorf("got: %t; want: %t (%s)", d.IsWorkday(date), c.IsWorkday(date), date)
+		}
+	}
+}
+
+func TestSpecRegions(t *testing.T) {
+	for _, code := range Regions() {
+		assertSpecRoundTrip(t, code, HolidaysForRegion(code))
+	}
+
+	sets := map[string]map[string][]Holiday{
+		"DE": germanStateHolidays,
+		"US": usStateHolidays,
+		"GB": britishRegionHolidays,
+		"AU": australianStateHolidays,
+		"CA": canadianProvinceHolidays,
+		"CH": swissCantonHolidays,
+		"ES": spanishRegionHolidays,
+		"IN": indianStateHolidays,
+		"NZ": newZealandRegionHolidays,
+	}
+	for country, set := range sets {
+		for region, hs := range set {
+			assertSpecRoundTrip(t, country+"-"+region, hs)
+		}
+	}
+}
+
+// assertSpecRoundTrip checks that a calendar with the holidays has the same
+// work days after it is converted to a spec and back.
+func assertSpecRoundTrip(t *testing.T, name string, hs []Holiday) {
+	t.Helper()
+	c := NewCalendar()
+	c.AddHolidays(hs...)
+	spec, err := c.ToSpec()
+	if err != nil {
+		t.Errorf("%s: unexpected error: %v", name, err)
+		return
+	}
+	d, err := FromSpec(spec)
+	if err != nil {
+		t.Errorf("%s: unexpected error: %v", name, err)
+		return
+	}
+	for date := time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC); date.Year() < 2027; date = date.AddDate(0, 0, 1) {
+		if c.IsWorkday(date) != d.IsWorkday(date) {
+			t.Errorf("%s: got: %t; want: %t (%s)", name, d.IsWorkday(date), c.IsWorkday(date), date)
+			return
+		}
+	}
+}
+
+func TestSpecWeekmasks(t *testing.T) {
+	tests := []struct {
+		name string
+		c    *Calendar
+	}{
+		{"AE", NewEmiratiCalendar()},
+		{"SA", NewSaudiCalendar()},
+	}
+
+	for _, test := range tests {
+		spec, err := test.c.ToSpec()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if len(spec.Weekmasks) == 0 {
+			t.Errorf("%s: expected the work week changes to be kept", test.name)
+		}
+		d, err := FromSpec(spec)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		for date := time.Date(2005, 1, 1, 12, 0, 0, 0, time.UTC); date.Year() < 2024; date = date.AddDate(0, 0, 1) {
+			if test.c.IsWorkday(date) != d.IsWorkday(date) {
+				t.Errorf("%s: got: %t; want: %t (%s)", test.name, d.IsWorkday(date), test.c.IsWorkday(date), date)
+			}
+		}
+	}
+}
+
+func TestSpecErrors(t *testing.T) {
+	piDay := func(year int, loc *time.Location) (time.Month, int) {
+		return time.March, 14
+	}
+	c := NewCalendar()
+	c.AddHoliday(NewHolidayFunc(piDay))
+	if _, err := c.ToSpec(); err == nil {
+		t.Errorf("Expected an error for an unregistered HolidayFn")
+	}
+	RegisterHolidayFunc("piDay", piDay)
+	defer delete(holidayFuncs, "piDay")
+	if spec, err := c.ToSpec(); err != nil || spec.Holidays[0].Func != "piDay" {
+		t.Errorf("got: %+v, %v; want the registered HolidayFn", spec, err)
+	}
+
+	c = NewCalendar()
+	c.WorkdayFunc = DefaultWorkday
+	if _, err := c.ToSpec(); err == nil {
+		t.Errorf("Expected an error for a WorkdayFunc")
+	}
+
+	spec := CalendarSpec{
+		Workdays: [7]bool{time.Monday: true},
+		Holidays: []HolidaySpec{{Func: "unknown"}},
+	}
+	if _, err := FromSpec(spec); err == nil {
+		t.Errorf("Expected an error for an unknown HolidayFn")
+	}
+	spec.Holidays = []HolidaySpec{{FuncOK: "unknown"}}
+	if _, err := FromSpec(spec); err == nil {
+		t.Errorf("Expected an error for an unknown HolidayFnOK")
+	}
+	spec.Holidays = []HolidaySpec{{Month: time.March, Day: 14, ObservedFunc: "unknown"}}
+	if _, err := FromSpec(spec); err == nil {
+		t.Errorf("Expected an error for an unknown ObservedFn")
+	}
+}